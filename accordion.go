@@ -0,0 +1,439 @@
+package ebui
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ FocusableComponent = &CollapsibleSection{}
+var _ EventBoundary = &CollapsibleSection{}
+
+// CollapsibleSectionColors represents the color scheme for a collapsible section
+type CollapsibleSectionColors struct {
+	Header        color.Color
+	HeaderHovered color.Color
+	HeaderText    color.Color
+	Arrow         color.Color
+	Background    color.Color
+	FocusBorder   color.Color
+}
+
+// DefaultCollapsibleSectionColors returns a default color scheme for collapsible sections
+func DefaultCollapsibleSectionColors() CollapsibleSectionColors {
+	return CollapsibleSectionColors{
+		Header:        color.RGBA{210, 210, 210, 255},
+		HeaderHovered: color.RGBA{190, 190, 190, 255},
+		HeaderText:    color.Black,
+		Arrow:         color.RGBA{60, 60, 60, 255},
+		Background:    color.RGBA{240, 240, 240, 255},
+		FocusBorder:   color.Black,
+	}
+}
+
+// CollapsibleSection is a container with a clickable header that expands and collapses its content.
+// The section's height follows its content, animating between collapsed and expanded.
+// Collapsed content is disabled, so it cannot be clicked or focused.
+type CollapsibleSection struct {
+	*BaseFocusable
+	*BaseContainer
+	title         string
+	content       *LayoutContainer
+	headerHeight  float64
+	expanded      bool
+	contentHeight float64 // Currently visible height of the content
+	speed         float64 // Fraction of the content height revealed per update, 0 for no animation
+	initialized   bool
+	colors        CollapsibleSectionColors
+	font          font.Face
+	onToggle      func(expanded bool)
+	accordion     *Accordion
+	isHovered     bool
+	isFocused     bool
+}
+
+// WithExpanded sets whether the section starts expanded
+func WithExpanded(expanded bool) ComponentOpt {
+	return func(c Component) {
+		if cs, ok := c.(*CollapsibleSection); ok {
+			cs.expanded = expanded
+		}
+	}
+}
+
+// WithSectionHeaderHeight sets the height of the section header
+func WithSectionHeaderHeight(height float64) ComponentOpt {
+	return func(c Component) {
+		if cs, ok := c.(*CollapsibleSection); ok {
+			cs.headerHeight = height
+		}
+	}
+}
+
+// WithCollapsibleSectionColors sets the colors for the collapsible section
+func WithCollapsibleSectionColors(colors CollapsibleSectionColors) ComponentOpt {
+	return func(c Component) {
+		if cs, ok := c.(*CollapsibleSection); ok {
+			cs.colors = colors
+		}
+	}
+}
+
+// WithToggleHandler sets a function called when the section is expanded or collapsed
+func WithToggleHandler(handler func(expanded bool)) ComponentOpt {
+	return func(c Component) {
+		if cs, ok := c.(*CollapsibleSection); ok {
+			cs.onToggle = handler
+		}
+	}
+}
+
+// NewCollapsibleSection creates a new collapsible section with the given header title.
+// Children added to the section are stacked vertically in its content area.
+func NewCollapsibleSection(title string, opts ...ComponentOpt) *CollapsibleSection {
+	cs := &CollapsibleSection{
+		BaseFocusable: NewBaseFocusable(),
+		BaseContainer: NewBaseContainer(opts...),
+		title:         title,
+		headerHeight:  28,
+		speed:         0.15,
+		colors:        DefaultCollapsibleSectionColors(),
+		font:          basicfont.Face7x13,
+		onToggle:      func(expanded bool) {},
+	}
+
+	for _, opt := range opts {
+		opt(cs)
+	}
+
+	cs.content = NewLayoutContainer(
+		WithPosition(Position{X: 0, Y: cs.headerHeight, Relative: true}),
+		WithPadding(8, 8, 8, 8),
+		WithLayout(NewVerticalStackLayout(8, AlignStart)),
+	)
+	cs.BaseContainer.AddChild(cs.content)
+	if !cs.expanded {
+		cs.content.Disable()
+	}
+
+	cs.SetSize(Size{Width: cs.GetSize().Width, Height: cs.headerHeight})
+	cs.registerEventListeners()
+	return cs
+}
+
+func (cs *CollapsibleSection) registerEventListeners() {
+	cs.AddEventListener(MouseMove, func(e *Event) {
+		cs.isHovered = e.Target == cs && cs.isOverHeader(e.MouseX, e.MouseY)
+	})
+
+	cs.AddEventListener(MouseLeave, func(e *Event) {
+		if e.Target == cs {
+			cs.isHovered = false
+		}
+	})
+
+	cs.AddEventListener(MouseDown, func(e *Event) {
+		if e.Target == cs && e.MouseButton == ebiten.MouseButtonLeft && cs.isOverHeader(e.MouseX, e.MouseY) {
+			cs.Toggle()
+		}
+	})
+
+	cs.AddEventListener(Focus, func(e *Event) {
+		cs.isFocused = true
+	})
+
+	cs.AddEventListener(Blur, func(e *Event) {
+		cs.isFocused = false
+	})
+}
+
+func (cs *CollapsibleSection) Update() error {
+	if cs.isFocused {
+		cs.handleKeyboardInput()
+	}
+
+	cs.content.ArrangeChildren()
+	naturalHeight := cs.getNaturalHeight()
+	width := cs.GetSize().Width
+	cs.content.SetSize(Size{Width: width, Height: naturalHeight})
+
+	// Animate the visible content height towards its target
+	target := 0.0
+	if cs.expanded {
+		target = naturalHeight
+	}
+	step := cs.speed * naturalHeight
+	if !cs.initialized || cs.speed <= 0 || math.Abs(target-cs.contentHeight) <= step {
+		cs.contentHeight = target
+	} else if cs.contentHeight < target {
+		cs.contentHeight += step
+	} else {
+		cs.contentHeight -= step
+	}
+	cs.initialized = true
+
+	cs.BaseContainer.SetSize(Size{Width: width, Height: cs.headerHeight + cs.contentHeight})
+	return cs.BaseContainer.Update()
+}
+
+func (cs *CollapsibleSection) handleKeyboardInput() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter), inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		cs.Toggle()
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		cs.SetExpanded(true)
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		cs.SetExpanded(false)
+	}
+}
+
+// getNaturalHeight returns the height of the content when fully expanded
+func (cs *CollapsibleSection) getNaturalHeight() float64 {
+	if len(cs.content.GetChildren()) == 0 {
+		return 0
+	}
+	return cs.content.layout.GetMinSize(cs.content).Height
+}
+
+func (cs *CollapsibleSection) isOverHeader(x, y float64) bool {
+	pos := cs.GetAbsolutePosition()
+	return x >= pos.X && x < pos.X+cs.GetSize().Width && y >= pos.Y && y < pos.Y+cs.headerHeight
+}
+
+// IsWithinBounds limits hit testing to the visible part of the section,
+// so content hidden while animating cannot be clicked
+func (cs *CollapsibleSection) IsWithinBounds(x, y float64) bool {
+	return cs.Contains(x, y)
+}
+
+func (cs *CollapsibleSection) Draw(screen *ebiten.Image) {
+	if cs.IsHidden() {
+		return
+	}
+
+	pos := cs.GetAbsolutePosition()
+	size := cs.GetSize()
+	if !size.IsDrawable() {
+		return
+	}
+
+	if cs.isFocused {
+		focusBorder := GetCache().BorderImageWithColor(int(size.Width+2), int(size.Height+2), cs.colors.FocusBorder)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-1, pos.Y-1)
+		screen.DrawImage(focusBorder, op)
+	}
+
+	cs.drawHeader(screen)
+
+	// Draw the content clipped to the revealed height
+	if cs.contentHeight >= 1 {
+		top := pos.Y + cs.headerHeight
+		bg := GetCache().ImageWithColor(int(size.Width), int(cs.contentHeight), cs.colors.Background)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X, top)
+		screen.DrawImage(bg, op)
+
+		clipped := screen.SubImage(image.Rect(
+			int(pos.X),
+			int(top),
+			int(pos.X+size.Width),
+			int(top+cs.contentHeight),
+		)).(*ebiten.Image)
+		cs.content.Draw(clipped)
+	}
+
+	cs.drawDebug(screen)
+}
+
+func (cs *CollapsibleSection) drawHeader(screen *ebiten.Image) {
+	pos := cs.GetAbsolutePosition()
+	width := cs.GetSize().Width
+
+	headerColor := cs.colors.Header
+	if cs.isHovered {
+		headerColor = cs.colors.HeaderHovered
+	}
+	header := GetCache().ImageWithColor(int(width), int(cs.headerHeight), headerColor)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(header, op)
+
+	direction := DirectionRight
+	if cs.expanded {
+		direction = DirectionDown
+	}
+	drawArrow(screen, pos.X+14, pos.Y+cs.headerHeight/2, 8, direction, cs.colors.Arrow)
+
+	metrics := cs.font.Metrics()
+	baseline := int(pos.Y + (cs.headerHeight-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil()))
+	text.Draw(screen, cs.title, cs.font, int(pos.X+28), baseline, cs.colors.HeaderText)
+}
+
+// AddChild adds a component to the section content
+func (cs *CollapsibleSection) AddChild(child Component) {
+	cs.content.AddChild(child)
+}
+
+// RemoveChild removes a component from the section content
+func (cs *CollapsibleSection) RemoveChild(child Component) {
+	cs.content.RemoveChild(child)
+}
+
+// GetContent returns the container holding the section content
+func (cs *CollapsibleSection) GetContent() *LayoutContainer {
+	return cs.content
+}
+
+// SetExpanded expands or collapses the section. A focused component inside a collapsing section loses focus.
+func (cs *CollapsibleSection) SetExpanded(expanded bool) {
+	if cs.expanded == expanded {
+		return
+	}
+	cs.expanded = expanded
+
+	// Content is disabled as soon as it starts collapsing, and enabled as soon as it starts expanding
+	if expanded {
+		cs.content.Enable()
+		if cs.accordion != nil {
+			cs.accordion.sectionExpanded(cs)
+		}
+	} else {
+		cs.content.Disable()
+	}
+
+	cs.onToggle(expanded)
+}
+
+// Toggle expands the section if collapsed, collapses it if expanded
+func (cs *CollapsibleSection) Toggle() {
+	cs.SetExpanded(!cs.expanded)
+}
+
+// IsExpanded returns whether the section is expanded
+func (cs *CollapsibleSection) IsExpanded() bool {
+	return cs.expanded
+}
+
+// SetTitle sets the header title
+func (cs *CollapsibleSection) SetTitle(title string) {
+	cs.title = title
+}
+
+// GetTitle returns the header title
+func (cs *CollapsibleSection) GetTitle() string {
+	return cs.title
+}
+
+// SetColors sets the color scheme for the section
+func (cs *CollapsibleSection) SetColors(colors CollapsibleSectionColors) {
+	cs.colors = colors
+}
+
+// Accordion stacks collapsible sections vertically, optionally keeping only one open at a time
+type Accordion struct {
+	*LayoutContainer
+	singleOpen bool
+}
+
+// WithSingleOpen makes opening a section of the accordion collapse the others
+func WithSingleOpen() ComponentOpt {
+	return func(c Component) {
+		if a, ok := c.(*Accordion); ok {
+			a.singleOpen = true
+		}
+	}
+}
+
+// NewAccordion creates a new accordion
+func NewAccordion(opts ...ComponentOpt) *Accordion {
+	// Default layout is a vertical stack
+	withLayout := WithLayout(NewVerticalStackLayout(2, AlignStart))
+	a := &Accordion{
+		LayoutContainer: NewLayoutContainer(
+			append([]ComponentOpt{withLayout}, opts...)...,
+		),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+func (a *Accordion) Update() error {
+	// Sections fill the accordion's width
+	padding := a.GetPadding()
+	width := a.GetSize().Width - padding.Left - padding.Right
+	for _, section := range a.GetSections() {
+		section.SetSize(Size{Width: width, Height: section.GetSize().Height})
+	}
+	return a.LayoutContainer.Update()
+}
+
+// AddChild adds a component to the accordion. Collapsible sections are linked to the
+// accordion so that single-open mode applies to them.
+func (a *Accordion) AddChild(child Component) {
+	if section, ok := child.(*CollapsibleSection); ok {
+		section.accordion = a
+		if a.singleOpen && section.expanded && a.GetExpanded() != nil {
+			section.SetExpanded(false)
+		}
+	}
+	a.LayoutContainer.AddChild(child)
+}
+
+// RemoveChild removes a component from the accordion
+func (a *Accordion) RemoveChild(child Component) {
+	if section, ok := child.(*CollapsibleSection); ok && section.accordion == a {
+		section.accordion = nil
+	}
+	a.LayoutContainer.RemoveChild(child)
+}
+
+// AddSection creates a collapsible section with the given title and adds it to the accordion
+func (a *Accordion) AddSection(title string, opts ...ComponentOpt) *CollapsibleSection {
+	section := NewCollapsibleSection(title, opts...)
+	a.AddChild(section)
+	return section
+}
+
+// GetSections returns the collapsible sections in the accordion
+func (a *Accordion) GetSections() []*CollapsibleSection {
+	var sections []*CollapsibleSection
+	for _, child := range a.GetChildren() {
+		if section, ok := child.(*CollapsibleSection); ok {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// GetExpanded returns the first expanded section, or nil if all are collapsed
+func (a *Accordion) GetExpanded() *CollapsibleSection {
+	for _, section := range a.GetSections() {
+		if section.expanded {
+			return section
+		}
+	}
+	return nil
+}
+
+// sectionExpanded collapses the other sections when only one may be open
+func (a *Accordion) sectionExpanded(expanded *CollapsibleSection) {
+	if !a.singleOpen {
+		return
+	}
+	for _, section := range a.GetSections() {
+		if section != expanded {
+			section.SetExpanded(false)
+		}
+	}
+}
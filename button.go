@@ -2,6 +2,9 @@ package ebui
 
 import (
 	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
 )
 
 var _ FocusableComponent = &Button{}
@@ -9,7 +12,29 @@ var _ FocusableComponent = &Button{}
 type Button struct {
 	*ButtonContainer
 
-	label *Label
+	label         *Label
+	icons         ButtonIcons
+	iconPlacement IconPlacement
+	iconSpacing   float64
+	iconSize      Size
+}
+
+// IconPlacement determines where a button's icon is placed relative to its label
+type IconPlacement int
+
+const (
+	IconPlacementLeft IconPlacement = iota
+	IconPlacementRight
+	IconPlacementAbove
+)
+
+// ButtonIcons holds the icon images for each button state.
+// Hovered, Pressed and Disabled fall back to Default when nil.
+type ButtonIcons struct {
+	Default  *ebiten.Image
+	Hovered  *ebiten.Image
+	Pressed  *ebiten.Image
+	Disabled *ebiten.Image
 }
 
 func WithLabelText(text string) ComponentOpt {
@@ -28,9 +53,58 @@ func WithLabelColor(color color.Color) ComponentOpt {
 	}
 }
 
+// WithIcon shows an image on the button, placed relative to the label.
+// Buttons without label text show the icon centered.
+func WithIcon(img *ebiten.Image, placement IconPlacement) ComponentOpt {
+	return func(c Component) {
+		if b, ok := c.(*Button); ok {
+			b.icons.Default = img
+			b.iconPlacement = placement
+		}
+	}
+}
+
+// WithButtonIcons sets the icons for each button state, such as for toolbar buttons
+func WithButtonIcons(icons ButtonIcons) ComponentOpt {
+	return func(c Component) {
+		if b, ok := c.(*Button); ok {
+			b.icons = icons
+		}
+	}
+}
+
+// WithIconPlacement sets where the icon is placed relative to the label
+func WithIconPlacement(placement IconPlacement) ComponentOpt {
+	return func(c Component) {
+		if b, ok := c.(*Button); ok {
+			b.iconPlacement = placement
+		}
+	}
+}
+
+// WithIconSpacing sets the space between the icon and the label
+func WithIconSpacing(spacing float64) ComponentOpt {
+	return func(c Component) {
+		if b, ok := c.(*Button); ok {
+			b.iconSpacing = spacing
+		}
+	}
+}
+
+// WithIconSize scales the icon to the given size instead of drawing it at its image size
+func WithIconSize(width, height float64) ComponentOpt {
+	return func(c Component) {
+		if b, ok := c.(*Button); ok {
+			b.iconSize = Size{Width: width, Height: height}
+		}
+	}
+}
+
 func NewButton(opts ...ComponentOpt) *Button {
 	b := &Button{
 		ButtonContainer: NewButtonContainer(opts...),
+		iconPlacement:   IconPlacementLeft,
+		iconSpacing:     6,
 	}
 
 	b.label = NewLabel(
@@ -47,6 +121,124 @@ func NewButton(opts ...ComponentOpt) *Button {
 	return b
 }
 
+func (b *Button) Update() error {
+	if err := b.ButtonContainer.Update(); err != nil {
+		return err
+	}
+	b.layoutLabel()
+	return nil
+}
+
+func (b *Button) Draw(screen *ebiten.Image) {
+	if b.IsHidden() {
+		return
+	}
+
+	b.ButtonContainer.Draw(screen)
+	b.drawIcon(screen)
+}
+
+// layoutLabel offsets the label text so the icon and label are centered together
+func (b *Button) layoutLabel() {
+	icon := b.getCurrentIcon()
+	if icon == nil || b.label.GetText() == "" {
+		b.label.justify = JustifyCenter
+		b.label.SetPadding(Padding{})
+		return
+	}
+
+	iconSize := b.getIconSize(icon)
+	textWidth := b.getLabelWidth()
+	labelPos := b.label.GetAbsolutePosition()
+	centerX, _ := b.getContentCenter()
+	groupStart := centerX - (iconSize.Width+b.iconSpacing+textWidth)/2
+
+	switch b.iconPlacement {
+	case IconPlacementLeft:
+		b.label.justify = JustifyLeft
+		b.label.SetPadding(Padding{Left: groupStart + iconSize.Width + b.iconSpacing - labelPos.X})
+	case IconPlacementRight:
+		b.label.justify = JustifyLeft
+		b.label.SetPadding(Padding{Left: groupStart - labelPos.X})
+	case IconPlacementAbove:
+		// The label centers its text vertically, so shift it down by half the icon
+		b.label.justify = JustifyCenter
+		b.label.SetPadding(Padding{Top: (iconSize.Height + b.iconSpacing) / 2})
+	}
+}
+
+func (b *Button) drawIcon(screen *ebiten.Image) {
+	icon := b.getCurrentIcon()
+	if icon == nil {
+		return
+	}
+
+	iconSize := b.getIconSize(icon)
+	centerX, centerY := b.getContentCenter()
+
+	x := centerX - iconSize.Width/2
+	y := centerY - iconSize.Height/2
+	if b.label.GetText() != "" {
+		textWidth := b.getLabelWidth()
+		textHeight := float64(b.label.GetTextHeight())
+		switch b.iconPlacement {
+		case IconPlacementLeft:
+			x = centerX - (iconSize.Width+b.iconSpacing+textWidth)/2
+		case IconPlacementRight:
+			x = centerX + (iconSize.Width+b.iconSpacing+textWidth)/2 - iconSize.Width
+		case IconPlacementAbove:
+			y = centerY - (iconSize.Height+b.iconSpacing+textHeight)/2
+		}
+	}
+
+	bounds := icon.Bounds()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(iconSize.Width/float64(bounds.Dx()), iconSize.Height/float64(bounds.Dy()))
+	op.GeoM.Translate(math.Round(x), math.Round(y))
+	if b.IsDisabled() && b.icons.Disabled == nil {
+		// Without a disabled icon, fade the default one
+		op.ColorScale.ScaleAlpha(0.5)
+	}
+	screen.DrawImage(icon, op)
+}
+
+// getCurrentIcon returns the icon for the button's current state
+func (b *Button) getCurrentIcon() *ebiten.Image {
+	switch {
+	case b.IsDisabled() && b.icons.Disabled != nil:
+		return b.icons.Disabled
+	case b.isPressed && b.isHovered && b.icons.Pressed != nil:
+		return b.icons.Pressed
+	case b.isHovered && b.icons.Hovered != nil:
+		return b.icons.Hovered
+	}
+	return b.icons.Default
+}
+
+func (b *Button) getIconSize(icon *ebiten.Image) Size {
+	if b.iconSize.Width > 0 && b.iconSize.Height > 0 {
+		return b.iconSize
+	}
+	bounds := icon.Bounds()
+	return Size{Width: float64(bounds.Dx()), Height: float64(bounds.Dy())}
+}
+
+// getLabelWidth returns the width of the widest line of the label text
+func (b *Button) getLabelWidth() float64 {
+	width := 0.0
+	for _, line := range b.label.lines {
+		width = math.Max(width, textBoundsWidth(b.label.font, []rune(line)))
+	}
+	return width
+}
+
+// getContentCenter returns the center of the area the label is centered in
+func (b *Button) getContentCenter() (float64, float64) {
+	pos := b.label.GetAbsolutePosition()
+	size := b.label.GetSize()
+	return pos.X + size.Width/2, pos.Y + size.Height/2
+}
+
 func (b *Button) GetLabel() string {
 	return b.label.GetText()
 }
@@ -54,3 +246,13 @@ func (b *Button) GetLabel() string {
 func (b *Button) SetLabel(text string) {
 	b.label.SetText(text)
 }
+
+// SetIcons sets the icons for each button state
+func (b *Button) SetIcons(icons ButtonIcons) {
+	b.icons = icons
+}
+
+// GetIcons returns the icons for each button state
+func (b *Button) GetIcons() ButtonIcons {
+	return b.icons
+}
@@ -13,6 +13,7 @@ type ButtonContainer struct {
 	*LayoutContainer
 	*BaseFocusable
 	colors    ButtonColors
+	skin      ButtonSkin
 	isHovered bool
 	isPressed bool
 	isFocused bool
@@ -35,6 +36,14 @@ func DefaultButtonColors() ButtonColors {
 	}
 }
 
+// ButtonSkin holds nine-slice textures for each button state.
+// Hovered and Pressed fall back to Default when nil.
+type ButtonSkin struct {
+	Default *NineSlice
+	Hovered *NineSlice
+	Pressed *NineSlice
+}
+
 func WithButtonColors(colors ButtonColors) ComponentOpt {
 	return func(c Component) {
 		if b, ok := c.(*ButtonContainer); ok {
@@ -43,6 +52,15 @@ func WithButtonColors(colors ButtonColors) ComponentOpt {
 	}
 }
 
+// WithButtonSkin draws the button with nine-slice textures instead of its background colors
+func WithButtonSkin(skin ButtonSkin) ComponentOpt {
+	return func(c Component) {
+		if b, ok := c.(*ButtonContainer); ok {
+			b.skin = skin
+		}
+	}
+}
+
 func WithClickHandler(handler func()) ComponentOpt {
 	return func(c Component) {
 		if b, ok := c.(*ButtonContainer); ok {
@@ -77,11 +95,13 @@ func (b *ButtonContainer) registerEventListeners() {
 
 	b.AddEventListener(MouseLeave, func(e *Event) {
 		b.isHovered = false
-		b.isPressed = false
 	})
 
+	// The pointer is captured while pressed, so the button stays pressed when the pointer leaves
+	// and only clicks if it is released over the button
 	b.AddEventListener(MouseDown, func(e *Event) {
 		b.isPressed = true
+		e.SetPointerCapture(b)
 	})
 
 	b.AddEventListener(MouseUp, func(e *Event) {
@@ -91,6 +111,10 @@ func (b *ButtonContainer) registerEventListeners() {
 		b.isPressed = false
 	})
 
+	b.AddEventListener(LostPointerCapture, func(e *Event) {
+		b.isPressed = false
+	})
+
 	b.AddEventListener(Focus, func(e *Event) {
 		b.isFocused = true
 	})
@@ -125,7 +149,7 @@ func (b *ButtonContainer) handleInput() {
 func (b *ButtonContainer) updateAppearance() {
 	var bgColor color.Color
 	switch {
-	case b.isPressed:
+	case b.isPressed && b.isHovered:
 		bgColor = b.colors.Pressed
 	case b.isHovered:
 		bgColor = b.colors.Hovered
@@ -133,6 +157,25 @@ func (b *ButtonContainer) updateAppearance() {
 		bgColor = b.colors.Default
 	}
 	b.BaseContainer.SetBackground(bgColor)
+
+	if b.skin.Default != nil {
+		nineSlice := b.skin.Default
+		switch {
+		case b.isPressed && b.isHovered && b.skin.Pressed != nil:
+			nineSlice = b.skin.Pressed
+		case b.isHovered && b.skin.Hovered != nil:
+			nineSlice = b.skin.Hovered
+		}
+		b.SetBackgroundNineSlice(nineSlice)
+	}
+}
+
+// SetSkin sets the nine-slice textures for the button states
+func (b *ButtonContainer) SetSkin(skin ButtonSkin) {
+	b.skin = skin
+	if skin.Default == nil {
+		b.SetBackgroundNineSlice(nil)
+	}
 }
 
 func (b *ButtonContainer) Draw(screen *ebiten.Image) {
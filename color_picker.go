@@ -0,0 +1,813 @@
+package ebui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ InteractiveComponent = &ColorPicker{}
+
+// ColorPickerColors represents the color scheme for a color picker
+type ColorPickerColors struct {
+	Background   color.Color
+	Border       color.Color
+	Text         color.Color
+	SwatchBorder color.Color
+	Marker       color.Color
+}
+
+// DefaultColorPickerColors returns a default color scheme for color pickers
+func DefaultColorPickerColors() ColorPickerColors {
+	return ColorPickerColors{
+		Background:   color.RGBA{240, 240, 240, 255},
+		Border:       color.RGBA{160, 160, 160, 255},
+		Text:         color.Black,
+		SwatchBorder: color.RGBA{120, 120, 120, 255},
+		Marker:       color.White,
+	}
+}
+
+// DefaultSwatches returns the default palette of a color picker
+func DefaultSwatches() []color.Color {
+	return []color.Color{
+		color.RGBA{0, 0, 0, 255},
+		color.RGBA{85, 85, 85, 255},
+		color.RGBA{170, 170, 170, 255},
+		color.RGBA{255, 255, 255, 255},
+		color.RGBA{220, 50, 50, 255},
+		color.RGBA{240, 140, 40, 255},
+		color.RGBA{245, 215, 60, 255},
+		color.RGBA{80, 180, 80, 255},
+		color.RGBA{40, 160, 160, 255},
+		color.RGBA{60, 110, 220, 255},
+		color.RGBA{130, 70, 200, 255},
+		color.RGBA{220, 90, 170, 255},
+		color.RGBA{120, 70, 40, 255},
+		color.RGBA{250, 200, 160, 255},
+	}
+}
+
+const (
+	colorPickerPadding   = 8.0
+	colorPickerSpacing   = 6.0
+	colorAreaHeight      = 150.0
+	colorSliderHeight    = 20.0
+	colorFieldHeight     = 24.0
+	colorPreviewWidth    = 36.0
+	colorSwatchSize      = 16.0
+	colorSwatchGap       = 4.0
+	colorMaxRecentColors = 8
+)
+
+// ColorPicker lets the user choose a color with a saturation/value area, hue and alpha sliders,
+// hex and RGB text fields, a palette of swatches and a row of recently picked colors
+type ColorPicker struct {
+	*BaseInteractive
+	*BaseContainer
+	hue         float64 // 0 to 360
+	saturation  float64 // 0 to 1
+	value       float64 // 0 to 1
+	alpha       float64 // 0 to 1
+	swatches    []color.Color
+	recent      []color.Color
+	colors      ColorPickerColors
+	font        font.Face
+	onChange    func(c color.Color)
+	updating    bool // Set while fields are synced to the color, to ignore their change handlers
+	edited      bool // Set when a text field changed the color since it was last committed
+	area        *colorArea
+	hueSlider   *Slider
+	alphaSlider *Slider
+	hexInput    *TextInput
+	rgbInputs   [3]*TextInput
+}
+
+// WithInitialColor sets the color the picker starts with
+func WithInitialColor(c color.Color) ComponentOpt {
+	return func(comp Component) {
+		if cp, ok := comp.(*ColorPicker); ok {
+			cp.setFromColor(c)
+		}
+	}
+}
+
+// WithSwatches sets the palette of swatches shown below the fields
+func WithSwatches(swatches ...color.Color) ComponentOpt {
+	return func(c Component) {
+		if cp, ok := c.(*ColorPicker); ok {
+			cp.swatches = swatches
+		}
+	}
+}
+
+// WithColorChangeHandler sets a function called whenever the user changes the color
+func WithColorChangeHandler(handler func(c color.Color)) ComponentOpt {
+	return func(c Component) {
+		if cp, ok := c.(*ColorPicker); ok {
+			cp.onChange = handler
+		}
+	}
+}
+
+// WithColorPickerColors sets the colors for the color picker
+func WithColorPickerColors(colors ColorPickerColors) ComponentOpt {
+	return func(c Component) {
+		if cp, ok := c.(*ColorPicker); ok {
+			cp.colors = colors
+		}
+	}
+}
+
+// NewColorPicker creates a new color picker. The height is set to fit its contents.
+func NewColorPicker(opts ...ComponentOpt) *ColorPicker {
+	cp := &ColorPicker{
+		BaseInteractive: NewBaseInteractive(),
+		BaseContainer:   NewBaseContainer(opts...),
+		saturation:      1,
+		value:           1,
+		alpha:           1,
+		swatches:        DefaultSwatches(),
+		colors:          DefaultColorPickerColors(),
+		font:            basicfont.Face7x13,
+		onChange:        func(c color.Color) {},
+	}
+
+	for _, opt := range opts {
+		opt(cp)
+	}
+
+	if cp.GetSize().Width <= 0 {
+		cp.SetSize(Size{Width: 240})
+	}
+
+	cp.createFields()
+	cp.layout()
+	cp.syncFields(nil)
+	cp.registerEventListeners()
+	return cp
+}
+
+func (cp *ColorPicker) createFields() {
+	cp.area = &colorArea{
+		BaseInteractive: NewBaseInteractive(),
+		BaseComponent:   NewBaseComponent(),
+		picker:          cp,
+		imageHue:        -1,
+	}
+	cp.area.registerEventListeners()
+
+	// The slider tracks are transparent so the gradients drawn beneath them show through
+	sliderColors := DefaultSliderColors()
+	sliderColors.Track = color.Transparent
+	sliderColors.TrackFilled = color.Transparent
+
+	cp.hueSlider = NewSlider(
+		WithMinValue(0),
+		WithMaxValue(360),
+		WithStepSize(1),
+		WithTrackHeight(10),
+		WithSliderColors(sliderColors),
+		WithOnChangeHandler(func(value float64) {
+			if cp.updating {
+				return
+			}
+			cp.hue = value
+			cp.colorChanged(cp.hueSlider)
+		}),
+	)
+	cp.alphaSlider = NewSlider(
+		WithMinValue(0),
+		WithMaxValue(255),
+		WithStepSize(1),
+		WithTrackHeight(10),
+		WithSliderColors(sliderColors),
+		WithOnChangeHandler(func(value float64) {
+			if cp.updating {
+				return
+			}
+			cp.alpha = value / 255
+			cp.colorChanged(cp.alphaSlider)
+		}),
+	)
+	for _, slider := range []*Slider{cp.hueSlider, cp.alphaSlider} {
+		slider.AddEventListener(MouseUp, func(e *Event) {
+			if e.MouseButton == ebiten.MouseButtonLeft {
+				cp.addRecent()
+			}
+		})
+	}
+
+	cp.hexInput = NewTextInput(
+		WithMaxLength(9),
+		WithInputFilter(func(r rune) bool {
+			return r == '#' || unicode.Is(unicode.ASCII_Hex_Digit, r)
+		}),
+		WithChangeHandler(func(text string) {
+			if cp.updating {
+				return
+			}
+			// Only complete colors are applied while typing
+			if c, ok := parseHexColor(text); ok {
+				cp.setFromColor(c)
+				cp.edited = true
+				cp.colorChanged(cp.hexInput)
+			}
+		}),
+		WithSubmitHandler(func(string) {
+			cp.commitField()
+		}),
+	)
+	cp.hexInput.AddEventListener(Blur, func(e *Event) {
+		cp.commitField()
+	})
+
+	for i := range cp.rgbInputs {
+		channel := i
+		input := NewTextInput(
+			WithMaxLength(3),
+			WithInputFilter(DigitsOnly),
+			WithChangeHandler(func(text string) {
+				if cp.updating || text == "" {
+					return
+				}
+				cp.setChannel(channel, text)
+			}),
+			WithSubmitHandler(func(string) {
+				cp.commitField()
+			}),
+		)
+		input.AddEventListener(Blur, func(e *Event) {
+			cp.commitField()
+		})
+		cp.rgbInputs[i] = input
+	}
+
+	cp.BaseContainer.AddChild(cp.area)
+	cp.BaseContainer.AddChild(cp.hueSlider)
+	cp.BaseContainer.AddChild(cp.alphaSlider)
+	cp.BaseContainer.AddChild(cp.hexInput)
+	for _, input := range cp.rgbInputs {
+		cp.BaseContainer.AddChild(input)
+	}
+}
+
+func (cp *ColorPicker) registerEventListeners() {
+	cp.AddEventListener(MouseDown, func(e *Event) {
+		if e.Target != cp || e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		if c, ok := cp.getSwatchAt(e.MouseX, e.MouseY); ok {
+			cp.setFromColor(c)
+			cp.colorChanged(nil)
+			cp.addRecent()
+		}
+	})
+}
+
+// layout positions the fields and sizes the picker to fit them
+func (cp *ColorPicker) layout() {
+	width := cp.GetSize().Width
+	inner := width - colorPickerPadding*2
+	y := colorPickerPadding
+
+	place := func(c Component, x, y, w, h float64) {
+		c.SetPosition(Position{X: x, Y: y, Relative: true})
+		c.SetSize(Size{Width: w, Height: h})
+	}
+
+	place(cp.area, colorPickerPadding, y, inner, colorAreaHeight)
+	y += colorAreaHeight + colorPickerSpacing
+	place(cp.hueSlider, colorPickerPadding, y, inner, colorSliderHeight)
+	y += colorSliderHeight + colorPickerSpacing
+	place(cp.alphaSlider, colorPickerPadding, y, inner, colorSliderHeight)
+	y += colorSliderHeight + colorPickerSpacing
+
+	hexX := colorPickerPadding + colorPreviewWidth + colorPickerSpacing
+	place(cp.hexInput, hexX, y, width-colorPickerPadding-hexX, colorFieldHeight)
+	y += colorFieldHeight + colorPickerSpacing
+
+	// Each RGB field is preceded by a one letter label
+	fieldWidth := (inner - colorPickerSpacing*2) / 3
+	for i, input := range cp.rgbInputs {
+		x := colorPickerPadding + float64(i)*(fieldWidth+colorPickerSpacing)
+		place(input, x+12, y, fieldWidth-12, colorFieldHeight)
+	}
+	y += colorFieldHeight + colorPickerSpacing
+
+	// Swatches, followed by a row of recent colors
+	rows := (len(cp.swatches) + cp.getSwatchColumns() - 1) / cp.getSwatchColumns()
+	y += float64(rows)*(colorSwatchSize+colorSwatchGap) + colorSwatchGap
+	y += colorSwatchSize + colorPickerPadding
+
+	cp.SetSize(Size{Width: width, Height: y})
+}
+
+// getSwatchColumns returns how many swatches fit in a row
+func (cp *ColorPicker) getSwatchColumns() int {
+	inner := cp.GetSize().Width - colorPickerPadding*2
+	return max(1, int((inner+colorSwatchGap)/(colorSwatchSize+colorSwatchGap)))
+}
+
+// getSwatchesTop returns the y of the swatch palette relative to the picker
+func (cp *ColorPicker) getSwatchesTop() float64 {
+	return colorPickerPadding + colorAreaHeight + colorSliderHeight*2 + colorFieldHeight*2 + colorPickerSpacing*5
+}
+
+// getSwatchBounds returns the absolute position of a palette swatch, or a recent color when recent is set
+func (cp *ColorPicker) getSwatchBounds(index int, recent bool) (float64, float64) {
+	pos := cp.GetAbsolutePosition()
+	columns := cp.getSwatchColumns()
+	row, column := index/columns, index%columns
+	if recent {
+		rows := (len(cp.swatches) + columns - 1) / columns
+		row, column = rows, index
+	}
+	x := pos.X + colorPickerPadding + float64(column)*(colorSwatchSize+colorSwatchGap)
+	y := pos.Y + cp.getSwatchesTop() + float64(row)*(colorSwatchSize+colorSwatchGap)
+	if recent {
+		y += colorSwatchGap
+	}
+	return x, y
+}
+
+// getSwatchAt returns the color of the palette or recent swatch at an absolute position
+func (cp *ColorPicker) getSwatchAt(x, y float64) (color.Color, bool) {
+	hit := func(sx, sy float64) bool {
+		return x >= sx && x < sx+colorSwatchSize && y >= sy && y < sy+colorSwatchSize
+	}
+	for i, c := range cp.swatches {
+		if hit(cp.getSwatchBounds(i, false)) {
+			return c, true
+		}
+	}
+	for i, c := range cp.recent {
+		if hit(cp.getSwatchBounds(i, true)) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// colorChanged syncs the other fields to the new color and notifies the change handler
+func (cp *ColorPicker) colorChanged(source Component) {
+	cp.syncFields(source)
+	cp.onChange(cp.GetColor())
+}
+
+// syncFields updates every field except the one being edited to show the current color
+func (cp *ColorPicker) syncFields(source Component) {
+	cp.updating = true
+	defer func() { cp.updating = false }()
+
+	c := cp.getNRGBA()
+	if source != cp.hueSlider {
+		cp.hueSlider.SetValue(cp.hue)
+	}
+	if source != cp.alphaSlider {
+		cp.alphaSlider.SetValue(float64(c.A))
+	}
+	if source != cp.hexInput {
+		setFieldText(cp.hexInput, formatHexColor(c))
+	}
+	for i, channel := range []uint8{c.R, c.G, c.B} {
+		if source != cp.rgbInputs[i] {
+			setFieldText(cp.rgbInputs[i], strconv.Itoa(int(channel)))
+		}
+	}
+}
+
+// setFieldText shows a value in a field without leaving an undo step. The field's earlier steps are discarded
+// too, since undoing them would restore a value from before the color was changed elsewhere.
+func setFieldText(field *TextInput, text string) {
+	if text == field.GetText() {
+		return
+	}
+	field.SetText(text)
+	field.ClearHistory()
+}
+
+// setChannel sets the red, green or blue channel from the text of its field
+func (cp *ColorPicker) setChannel(channel int, text string) {
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		return
+	}
+	c := cp.getNRGBA()
+	channels := []*uint8{&c.R, &c.G, &c.B}
+	*channels[channel] = uint8(clamp(float64(value), 0, 255))
+	cp.setFromColor(c)
+	cp.edited = true
+	cp.colorChanged(cp.rgbInputs[channel])
+}
+
+// commitField reformats the text fields and records the color once editing is finished
+func (cp *ColorPicker) commitField() {
+	cp.syncFields(nil)
+	if cp.edited {
+		cp.edited = false
+		cp.addRecent()
+	}
+}
+
+// setFromColor sets the HSV and alpha values from a color, keeping the hue for grays
+func (cp *ColorPicker) setFromColor(c color.Color) {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	h, s, v := rgbToHSV(n.R, n.G, n.B)
+	if s > 0 && v > 0 {
+		cp.hue = h
+	}
+	if v > 0 {
+		cp.saturation = s
+	}
+	cp.value = v
+	cp.alpha = float64(n.A) / 255
+}
+
+func (cp *ColorPicker) getNRGBA() color.NRGBA {
+	r, g, b := hsvToRGB(cp.hue, cp.saturation, cp.value)
+	return color.NRGBA{R: r, G: g, B: b, A: uint8(math.Round(cp.alpha * 255))}
+}
+
+// addRecent adds the current color to the front of the recent colors
+func (cp *ColorPicker) addRecent() {
+	c := cp.getNRGBA()
+	recent := []color.Color{c}
+	for _, r := range cp.recent {
+		if color.NRGBAModel.Convert(r) != c && len(recent) < colorMaxRecentColors {
+			recent = append(recent, r)
+		}
+	}
+	cp.recent = recent
+}
+
+func (cp *ColorPicker) Draw(screen *ebiten.Image) {
+	if cp.IsHidden() {
+		return
+	}
+
+	pos := cp.GetAbsolutePosition()
+	size := cp.GetSize()
+	if !size.IsDrawable() {
+		return
+	}
+
+	border := GetCache().ImageWithColor(int(size.Width), int(size.Height), cp.colors.Border)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(border, op)
+	bg := GetCache().ImageWithColor(int(size.Width-2), int(size.Height-2), cp.colors.Background)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X+1, pos.Y+1)
+	screen.DrawImage(bg, op)
+
+	cp.drawSliderGradients(screen)
+
+	// Preview of the current color next to the hex field
+	hexPos := cp.hexInput.GetAbsolutePosition()
+	cp.drawSwatch(screen, pos.X+colorPickerPadding, hexPos.Y, colorPreviewWidth, colorFieldHeight, cp.getNRGBA())
+
+	metrics := cp.font.Metrics()
+	for i, label := range []string{"R", "G", "B"} {
+		inputPos := cp.rgbInputs[i].GetAbsolutePosition()
+		baseline := int(inputPos.Y + (colorFieldHeight-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil()))
+		text.Draw(screen, label, cp.font, int(inputPos.X-11), baseline, cp.colors.Text)
+	}
+
+	for i, c := range cp.swatches {
+		x, y := cp.getSwatchBounds(i, false)
+		cp.drawSwatch(screen, x, y, colorSwatchSize, colorSwatchSize, c)
+	}
+	for i := 0; i < colorMaxRecentColors; i++ {
+		x, y := cp.getSwatchBounds(i, true)
+		if x+colorSwatchSize > pos.X+size.Width-colorPickerPadding {
+			break
+		}
+		if i < len(cp.recent) {
+			cp.drawSwatch(screen, x, y, colorSwatchSize, colorSwatchSize, cp.recent[i])
+		} else {
+			// Empty slots for recent colors not picked yet
+			slot := GetCache().BorderImageWithColor(int(colorSwatchSize), int(colorSwatchSize), cp.colors.Border)
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(x, y)
+			screen.DrawImage(slot, op)
+		}
+	}
+
+	cp.BaseContainer.Draw(screen)
+}
+
+// drawSliderGradients draws the hue spectrum and alpha ramp beneath the slider tracks
+func (cp *ColorPicker) drawSliderGradients(screen *ebiten.Image) {
+	for _, slider := range []*Slider{cp.hueSlider, cp.alphaSlider} {
+		x := slider.getTrackStart()
+		w := slider.getTrackLength()
+		y := slider.getCrossCenter() - slider.trackHeight/2
+		h := slider.trackHeight
+
+		var gradient *ebiten.Image
+		op := &ebiten.DrawImageOptions{}
+		if slider == cp.hueSlider {
+			gradient = getHueGradient()
+		} else {
+			drawChecker(screen, x, y, w, h)
+			gradient = getAlphaGradient()
+			c := cp.getNRGBA()
+			c.A = 255
+			op.ColorScale.ScaleWithColor(c)
+		}
+		op.GeoM.Scale(w/float64(gradient.Bounds().Dx()), h)
+		op.GeoM.Translate(x, y)
+		screen.DrawImage(gradient, op)
+	}
+}
+
+// drawSwatch draws a color over a checkerboard, so transparency is visible, with a border
+func (cp *ColorPicker) drawSwatch(screen *ebiten.Image, x, y, w, h float64, c color.Color) {
+	drawChecker(screen, x, y, w, h)
+	fill := GetCache().ImageWithColor(int(w), int(h), c)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x, y)
+	screen.DrawImage(fill, op)
+	border := GetCache().BorderImageWithColor(int(w), int(h), cp.colors.SwatchBorder)
+	screen.DrawImage(border, op)
+}
+
+// GetColor returns the selected color
+func (cp *ColorPicker) GetColor() color.Color {
+	return cp.getNRGBA()
+}
+
+// SetColor sets the selected color without calling the change handler
+func (cp *ColorPicker) SetColor(c color.Color) {
+	cp.setFromColor(c)
+	cp.syncFields(nil)
+}
+
+// GetRecentColors returns the recently picked colors, most recent first
+func (cp *ColorPicker) GetRecentColors() []color.Color {
+	return cp.recent
+}
+
+// SetRecentColors sets the recently picked colors, such as ones saved from a previous session
+func (cp *ColorPicker) SetRecentColors(colors []color.Color) {
+	cp.recent = colors[:min(len(colors), colorMaxRecentColors)]
+}
+
+// SetSwatches sets the palette of swatches
+func (cp *ColorPicker) SetSwatches(swatches []color.Color) {
+	cp.swatches = swatches
+	cp.layout()
+}
+
+// SetColors sets the color scheme for the color picker
+func (cp *ColorPicker) SetColors(colors ColorPickerColors) {
+	cp.colors = colors
+}
+
+// colorArea is the saturation/value square of a color picker.
+// Saturation increases to the right and value increases upwards.
+type colorArea struct {
+	*BaseInteractive
+	*BaseComponent
+	picker     *ColorPicker
+	image      *ebiten.Image
+	imageHue   float64
+	isDragging bool
+}
+
+func (a *colorArea) registerEventListeners() {
+	a.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		a.isDragging = true
+		e.SetPointerCapture(a)
+		a.updateFromPosition(e.MouseX, e.MouseY)
+	})
+
+	a.AddEventListener(MouseMove, func(e *Event) {
+		if a.isDragging {
+			a.updateFromPosition(e.MouseX, e.MouseY)
+		}
+	})
+
+	a.AddEventListener(MouseUp, func(e *Event) {
+		if a.isDragging && e.MouseButton == ebiten.MouseButtonLeft {
+			a.isDragging = false
+			a.picker.addRecent()
+		}
+	})
+
+	a.AddEventListener(LostPointerCapture, func(e *Event) {
+		a.isDragging = false
+	})
+}
+
+func (a *colorArea) updateFromPosition(x, y float64) {
+	pos := a.GetAbsolutePosition()
+	size := a.GetSize()
+	a.picker.saturation = clamp((x-pos.X)/size.Width, 0, 1)
+	a.picker.value = 1 - clamp((y-pos.Y)/size.Height, 0, 1)
+	a.picker.colorChanged(a)
+}
+
+func (a *colorArea) Draw(screen *ebiten.Image) {
+	pos := a.GetAbsolutePosition()
+	size := a.GetSize()
+	w, h := int(size.Width), int(size.Height)
+	if w <= 0 || h <= 0 {
+		return
+	}
+
+	// Regenerate the gradient when the hue or size changes
+	if a.image == nil || a.imageHue != a.picker.hue || a.image.Bounds().Dx() != w || a.image.Bounds().Dy() != h {
+		a.generateImage(w, h)
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(a.image, op)
+
+	// Marker around the selected saturation and value
+	mx := pos.X + a.picker.saturation*size.Width
+	my := pos.Y + (1-a.picker.value)*size.Height
+	marker := GetCache().BorderImageWithColor(7, 7, a.picker.colors.Marker)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(mx-3, my-3)
+	screen.DrawImage(marker, op)
+	outline := GetCache().BorderImageWithColor(9, 9, color.Black)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(mx-4, my-4)
+	screen.DrawImage(outline, op)
+}
+
+func (a *colorArea) generateImage(w, h int) {
+	if a.image == nil || a.image.Bounds().Dx() != w || a.image.Bounds().Dy() != h {
+		a.image = ebiten.NewImage(w, h)
+	}
+	pixels := make([]byte, w*h*4)
+	for y := 0; y < h; y++ {
+		v := 1 - float64(y)/float64(max(h-1, 1))
+		for x := 0; x < w; x++ {
+			s := float64(x) / float64(max(w-1, 1))
+			r, g, b := hsvToRGB(a.picker.hue, s, v)
+			i := (y*w + x) * 4
+			pixels[i], pixels[i+1], pixels[i+2], pixels[i+3] = r, g, b, 255
+		}
+	}
+	a.image.WritePixels(pixels)
+	a.imageHue = a.picker.hue
+}
+
+var (
+	hueGradient   *ebiten.Image
+	alphaGradient *ebiten.Image
+)
+
+// getHueGradient returns a 360x1 image of the hue spectrum
+func getHueGradient() *ebiten.Image {
+	if hueGradient == nil {
+		pixels := make([]byte, 360*4)
+		for x := 0; x < 360; x++ {
+			r, g, b := hsvToRGB(float64(x), 1, 1)
+			pixels[x*4], pixels[x*4+1], pixels[x*4+2], pixels[x*4+3] = r, g, b, 255
+		}
+		hueGradient = ebiten.NewImage(360, 1)
+		hueGradient.WritePixels(pixels)
+	}
+	return hueGradient
+}
+
+// getAlphaGradient returns a 256x1 image fading from transparent to opaque white
+func getAlphaGradient() *ebiten.Image {
+	if alphaGradient == nil {
+		pixels := make([]byte, 256*4)
+		for x := 0; x < 256; x++ {
+			// Pixels are premultiplied by alpha
+			pixels[x*4], pixels[x*4+1], pixels[x*4+2], pixels[x*4+3] = byte(x), byte(x), byte(x), byte(x)
+		}
+		alphaGradient = ebiten.NewImage(256, 1)
+		alphaGradient.WritePixels(pixels)
+	}
+	return alphaGradient
+}
+
+// drawChecker draws a light and dark checkerboard used behind transparent colors
+func drawChecker(screen *ebiten.Image, x, y, w, h float64) {
+	const cell = 4
+	light := GetCache().ImageWithColor(int(w), int(h), color.RGBA{255, 255, 255, 255})
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x, y)
+	screen.DrawImage(light, op)
+
+	for cy := 0.0; cy < h; cy += cell {
+		for cx := 0.0; cx < w; cx += cell {
+			if int(cx/cell+cy/cell)%2 == 0 {
+				continue
+			}
+			dark := GetCache().ImageWithColor(int(math.Min(cell, w-cx)), int(math.Min(cell, h-cy)), color.RGBA{200, 200, 200, 255})
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(x+cx, y+cy)
+			screen.DrawImage(dark, op)
+		}
+	}
+}
+
+// hsvToRGB converts a hue from 0 to 360 and saturation and value from 0 to 1 into RGB
+func hsvToRGB(h, s, v float64) (uint8, uint8, uint8) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	c := v * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := v - c
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	toByte := func(f float64) uint8 {
+		return uint8(math.Round(clamp((f+m)*255, 0, 255)))
+	}
+	return toByte(r), toByte(g), toByte(b)
+}
+
+// rgbToHSV converts RGB into a hue from 0 to 360 and saturation and value from 0 to 1
+func rgbToHSV(r, g, b uint8) (float64, float64, float64) {
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	maxC := math.Max(rf, math.Max(gf, bf))
+	minC := math.Min(rf, math.Min(gf, bf))
+	delta := maxC - minC
+
+	var h float64
+	switch {
+	case delta == 0:
+		h = 0
+	case maxC == rf:
+		h = 60 * math.Mod((gf-bf)/delta, 6)
+	case maxC == gf:
+		h = 60 * ((bf-rf)/delta + 2)
+	default:
+		h = 60 * ((rf-gf)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+
+	s := 0.0
+	if maxC > 0 {
+		s = delta / maxC
+	}
+	return h, s, maxC
+}
+
+// formatHexColor formats a color as #RRGGBB, or #RRGGBBAA when it is translucent
+func formatHexColor(c color.NRGBA) string {
+	if c.A == 255 {
+		return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02X%02X%02X%02X", c.R, c.G, c.B, c.A)
+}
+
+// parseHexColor parses a color in the form RRGGBB or RRGGBBAA, with an optional leading #
+func parseHexColor(s string) (color.NRGBA, bool) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) != 6 && len(s) != 8 {
+		return color.NRGBA{}, false
+	}
+	value, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	if len(s) == 6 {
+		value = value<<8 | 0xFF
+	}
+	return color.NRGBA{
+		R: uint8(value >> 24),
+		G: uint8(value >> 16),
+		B: uint8(value >> 8),
+		A: uint8(value),
+	}, true
+}
@@ -84,9 +84,11 @@ type BaseComponent struct {
 	size       Size
 	padding    Padding
 	background color.Color
+	nineSlice  *NineSlice
 	parent     Container
 	disabled   bool
 	hidden     bool
+	cursor     ebiten.CursorShapeType
 }
 
 func WithBackground(color color.Color) ComponentOpt {
@@ -97,6 +99,28 @@ func WithBackground(color color.Color) ComponentOpt {
 	}
 }
 
+// WithBackgroundNineSlice draws a nine-slice texture as the background instead of the background color
+func WithBackgroundNineSlice(nineSlice *NineSlice) ComponentOpt {
+	return func(c Component) {
+		switch bc := c.(type) {
+		case *BaseComponent:
+			bc.nineSlice = nineSlice
+		case *Tooltip:
+			// Tooltip options aren't passed to its container
+			bc.SetBackgroundNineSlice(nineSlice)
+		}
+	}
+}
+
+// WithCursor sets the shape of the mouse cursor while the component is hovered
+func WithCursor(shape ebiten.CursorShapeType) ComponentOpt {
+	return func(c Component) {
+		if bc, ok := c.(*BaseComponent); ok {
+			bc.cursor = shape
+		}
+	}
+}
+
 func WithHidden() ComponentOpt {
 	return func(c Component) {
 		if bc, ok := c.(*BaseComponent); ok {
@@ -178,6 +202,16 @@ func (b *BaseComponent) SetBackground(color color.Color) {
 	b.background = color
 }
 
+// SetBackgroundNineSlice sets a nine-slice texture that is drawn instead of the background color.
+// Pass nil to use the background color again.
+func (b *BaseComponent) SetBackgroundNineSlice(nineSlice *NineSlice) {
+	b.nineSlice = nineSlice
+}
+
+func (b *BaseComponent) GetBackgroundNineSlice() *NineSlice {
+	return b.nineSlice
+}
+
 func (b *BaseComponent) Disable() {
 	b.disabled = true
 }
@@ -202,12 +236,32 @@ func (b *BaseComponent) IsHidden() bool {
 	return b.hidden
 }
 
+// SetCursor sets the shape of the mouse cursor while the component is hovered
+func (b *BaseComponent) SetCursor(shape ebiten.CursorShapeType) {
+	b.cursor = shape
+}
+
+// GetCursor returns the shape of the mouse cursor while the component is hovered
+func (b *BaseComponent) GetCursor() ebiten.CursorShapeType {
+	return b.cursor
+}
+
+// GetCursorAt returns the cursor shape for the pointer at the given position.
+// Components override it to vary the cursor within their bounds.
+func (b *BaseComponent) GetCursorAt(x, y float64) ebiten.CursorShapeType {
+	return b.cursor
+}
+
 func (b *BaseComponent) drawBackground(screen *ebiten.Image) {
+	pos := b.GetAbsolutePosition()
+	size := b.GetSize()
+	if b.nineSlice != nil {
+		b.nineSlice.Draw(screen, pos.X, pos.Y, size.Width, size.Height)
+		return
+	}
 	if b.background == nil {
 		return
 	}
-	pos := b.GetAbsolutePosition()
-	size := b.GetSize()
 	bg := GetCache().ImageWithColor(int(size.Width), int(size.Height), b.background)
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(pos.X, pos.Y)
@@ -0,0 +1,52 @@
+package ebui
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// CursorProvider is implemented by components that set the mouse cursor shape while hovered.
+// All components embedding BaseComponent implement it, see WithCursor.
+type CursorProvider interface {
+	GetCursorAt(x, y float64) ebiten.CursorShapeType
+}
+
+// priorityCursorProvider is implemented by components whose cursor takes precedence over their descendants'
+// in parts of their bounds, such as the resize edges of a window that overlap the components along them
+type priorityCursorProvider interface {
+	getPriorityCursorAt(x, y float64) (ebiten.CursorShapeType, bool)
+}
+
+// resolveCursor returns the cursor of the outermost component in the path with a priority cursor at the point,
+// or else the cursor of the innermost component in the path that sets one
+func resolveCursor(path []InteractiveComponent, x, y float64) ebiten.CursorShapeType {
+	for _, c := range path {
+		if provider, ok := c.(priorityCursorProvider); ok {
+			if shape, ok := provider.getPriorityCursorAt(x, y); ok {
+				return shape
+			}
+		}
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		if provider, ok := path[i].(CursorProvider); ok {
+			if shape := provider.GetCursorAt(x, y); shape != ebiten.CursorShapeDefault {
+				return shape
+			}
+		}
+	}
+	return ebiten.CursorShapeDefault
+}
+
+// updateCursor applies the cursor of the hovered components, or of the component that has captured the pointer
+func (im *InputManager) updateCursor(path []InteractiveComponent, x, y float64) {
+	shape := resolveCursor(path, x, y)
+	if im.pointerCapture != nil {
+		shape = ebiten.CursorShapeDefault
+		if provider, ok := im.pointerCapture.(CursorProvider); ok {
+			shape = provider.GetCursorAt(x, y)
+		}
+	}
+
+	if shape != im.cursor {
+		ebiten.SetCursorShape(shape)
+		im.cursor = shape
+	}
+}
@@ -0,0 +1,1045 @@
+package ebui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ FocusableComponent = &DataTable{}
+var _ Scrollable = &DataTable{}
+
+// SortDirection represents the sort order of a table column
+type SortDirection int
+
+const (
+	SortNone SortDirection = iota
+	SortAscending
+	SortDescending
+)
+
+// SelectionMode controls how many rows can be selected at once
+type SelectionMode int
+
+const (
+	SelectionNone SelectionMode = iota
+	SelectionSingle
+	SelectionMultiple
+)
+
+// CellRenderer draws a single cell value within the given bounds.
+// The screen passed to the renderer is already clipped to the cell bounds.
+type CellRenderer func(screen *ebiten.Image, value any, bounds image.Rectangle, textColor color.Color)
+
+// DataTableColumn describes a column of a DataTable
+type DataTableColumn struct {
+	Title    string
+	Width    float64
+	MinWidth float64
+	Sortable bool
+	// Less compares two cell values when sorting (optional, a default comparison is used if nil)
+	Less func(a, b any) bool
+	// Renderer draws the cells of this column (optional, values are drawn as text if nil)
+	Renderer CellRenderer
+}
+
+// DataTableRow holds the cell values of a row, one per column
+type DataTableRow []any
+
+// DataTableColors represents the color scheme for a data table
+type DataTableColors struct {
+	Background  color.Color
+	Header      color.Color
+	HeaderText  color.Color
+	Text        color.Color
+	RowAlt      color.Color
+	RowHovered  color.Color
+	RowSelected color.Color
+	GridLine    color.Color
+	Track       color.Color
+	Thumb       color.Color
+	ThumbDrag   color.Color
+	FocusBorder color.Color
+}
+
+// DefaultDataTableColors returns a default color scheme for data tables
+func DefaultDataTableColors() DataTableColors {
+	return DataTableColors{
+		Background:  color.White,
+		Header:      color.RGBA{200, 200, 200, 255},
+		HeaderText:  color.Black,
+		Text:        color.Black,
+		RowAlt:      color.RGBA{245, 245, 245, 255},
+		RowHovered:  color.RGBA{225, 235, 250, 255},
+		RowSelected: color.RGBA{100, 149, 237, 127}, // Cornflower blue
+		GridLine:    color.RGBA{170, 170, 170, 255},
+		Track:       color.RGBA{200, 200, 200, 255},
+		Thumb:       color.RGBA{160, 160, 160, 255},
+		ThumbDrag:   color.RGBA{120, 120, 120, 255},
+		FocusBorder: color.Black,
+	}
+}
+
+// DataTable is a virtualized grid component with a fixed header row,
+// sortable and resizable columns, and row selection
+type DataTable struct {
+	*BaseFocusable
+	*BaseContainer
+	columns           []DataTableColumn
+	rows              []DataTableRow
+	order             []int        // Display order of rows (indices into rows)
+	selected          map[int]bool // Selected rows (indices into rows)
+	cursorRow         int          // Keyboard cursor (display index)
+	anchorRow         int          // Anchor for shift selection (display index)
+	hoveredRow        int          // Hovered row (display index)
+	isHovered         bool
+	pointerX          float64 // Last pointer position over the table, to update the hovered row on scroll
+	pointerY          float64
+	sortColumn        int
+	sortDirection     SortDirection
+	selectionMode     SelectionMode
+	headerHeight      float64
+	rowHeight         float64
+	cellPadding       float64
+	scrollOffset      Position
+	scrollBarWidth    float64
+	isScrollBarHidden bool
+	isDraggingThumb   bool
+	dragStartY        float64
+	dragStartOffset   float64
+	resizingColumn    int
+	resizeStartX      float64
+	resizeStartWidth  float64
+	pressedColumn     int
+	isFocused         bool
+	colors            DataTableColors
+	font              font.Face
+	onSelectionChange func(selected []int)
+	onSort            func(column int, direction SortDirection)
+}
+
+// WithColumns sets the columns of the data table
+func WithColumns(columns ...DataTableColumn) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.columns = columns
+		}
+	}
+}
+
+// WithRows sets the initial rows of the data table
+func WithRows(rows []DataTableRow) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.rows = rows
+		}
+	}
+}
+
+// WithRowHeight sets the height of each table row
+func WithRowHeight(height float64) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.rowHeight = height
+		}
+	}
+}
+
+// WithTableHeaderHeight sets the height of the table header row
+func WithTableHeaderHeight(height float64) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.headerHeight = height
+		}
+	}
+}
+
+// WithSelectionMode sets how many rows can be selected at once
+func WithSelectionMode(mode SelectionMode) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.selectionMode = mode
+		}
+	}
+}
+
+// WithDataTableColors sets the colors for the data table
+func WithDataTableColors(colors DataTableColors) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.colors = colors
+		}
+	}
+}
+
+// WithSelectionChangeHandler sets the handler for row selection changes
+func WithSelectionChangeHandler(handler func(selected []int)) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.onSelectionChange = handler
+		}
+	}
+}
+
+// WithSortHandler sets the handler called when the sort column or direction changes
+func WithSortHandler(handler func(column int, direction SortDirection)) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*DataTable); ok {
+			t.onSort = handler
+		}
+	}
+}
+
+// NewDataTable creates a new data table component
+func NewDataTable(opts ...ComponentOpt) *DataTable {
+	t := &DataTable{
+		BaseFocusable:     NewBaseFocusable(),
+		BaseContainer:     NewBaseContainer(opts...),
+		selected:          make(map[int]bool),
+		cursorRow:         -1,
+		anchorRow:         -1,
+		hoveredRow:        -1,
+		sortColumn:        -1,
+		selectionMode:     SelectionSingle,
+		headerHeight:      24,
+		rowHeight:         22,
+		cellPadding:       6,
+		scrollBarWidth:    12,
+		resizingColumn:    -1,
+		pressedColumn:     -1,
+		colors:            DefaultDataTableColors(),
+		font:              basicfont.Face7x13,
+		onSelectionChange: func([]int) {},
+		onSort:            func(int, SortDirection) {},
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	t.SetBackground(t.colors.Background)
+	t.applySort()
+	t.registerEventListeners()
+
+	return t
+}
+
+func (t *DataTable) registerEventListeners() {
+	t.AddEventListener(MouseMove, func(e *Event) {
+		t.isHovered = true
+		t.pointerX, t.pointerY = e.MouseX, e.MouseY
+		t.hoveredRow = t.rowAt(e.MouseX, e.MouseY)
+	})
+
+	t.AddEventListener(MouseLeave, func(e *Event) {
+		t.isHovered = false
+		t.hoveredRow = -1
+	})
+
+	t.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+
+		if t.isOverHeader(e.MouseX, e.MouseY) {
+			if t.dividerAt(e.MouseX, e.MouseY) == -1 {
+				t.pressedColumn = t.columnAt(e.MouseX)
+			} else {
+				// Capture the pointer so the resize cursor stays while resizing the column
+				e.SetPointerCapture(t)
+			}
+			return
+		}
+
+		if t.isOverScrollBar(e.MouseX, e.MouseY) {
+			return
+		}
+
+		if row := t.rowAt(e.MouseX, e.MouseY); row != -1 {
+			t.clickRow(row)
+		}
+	})
+
+	t.AddEventListener(MouseUp, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+
+		if t.pressedColumn != -1 && t.isOverHeader(e.MouseX, e.MouseY) && t.columnAt(e.MouseX) == t.pressedColumn {
+			t.toggleSort(t.pressedColumn)
+		}
+		t.pressedColumn = -1
+	})
+
+	t.AddEventListener(DragStart, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+
+		if divider := t.dividerAt(e.MouseX, e.MouseY); divider != -1 {
+			t.resizingColumn = divider
+			t.resizeStartX = e.MouseX
+			t.resizeStartWidth = t.columns[divider].Width
+			t.pressedColumn = -1
+			return
+		}
+
+		if t.isOverScrollThumb(e.MouseX, e.MouseY) {
+			t.isDraggingThumb = true
+			t.dragStartY = e.MouseY
+			t.dragStartOffset = t.scrollOffset.Y
+		}
+	})
+
+	t.AddEventListener(Drag, func(e *Event) {
+		if t.resizingColumn != -1 {
+			t.SetColumnWidth(t.resizingColumn, t.resizeStartWidth+e.MouseX-t.resizeStartX)
+			return
+		}
+
+		if t.isDraggingThumb {
+			trackSpace := t.getViewportHeight() - t.getScrollThumbHeight()
+			if trackSpace <= 0 {
+				return
+			}
+			scrollRatio := t.getMaxScroll() / trackSpace
+			scrollOffset := t.GetScrollOffset()
+			scrollOffset.Y = t.dragStartOffset + (e.MouseY-t.dragStartY)*scrollRatio
+			t.SetScrollOffset(scrollOffset)
+		}
+	})
+
+	t.AddEventListener(DragEnd, func(e *Event) {
+		t.resizingColumn = -1
+		t.isDraggingThumb = false
+	})
+
+	t.AddEventListener(Wheel, func(e *Event) {
+		scrollOffset := t.GetScrollOffset()
+		// Shift turns the vertical wheel into horizontal scrolling, for mice without a horizontal wheel
+		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+			scrollOffset.X -= e.WheelDeltaY * t.rowHeight
+		} else {
+			scrollOffset.Y -= e.WheelDeltaY * t.rowHeight
+		}
+		scrollOffset.X -= e.WheelDeltaX * t.rowHeight
+		t.SetScrollOffset(scrollOffset)
+	})
+
+	t.AddEventListener(Focus, func(e *Event) {
+		t.isFocused = true
+	})
+
+	t.AddEventListener(Blur, func(e *Event) {
+		t.isFocused = false
+	})
+}
+
+func (t *DataTable) Update() error {
+	// The table may have been resized since it was scrolled
+	t.clampScrollOffset()
+	t.handleInput()
+	return t.BaseContainer.Update()
+}
+
+func (t *DataTable) handleInput() {
+	if !t.isFocused || len(t.order) == 0 {
+		return
+	}
+
+	pageRows := int(t.getViewportHeight() / t.rowHeight)
+	ctrlPressed := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyArrowUp):
+		t.moveCursor(t.cursorRow - 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyArrowDown):
+		t.moveCursor(t.cursorRow + 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageUp):
+		t.moveCursor(t.cursorRow - pageRows)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageDown):
+		t.moveCursor(t.cursorRow + pageRows)
+	case inpututil.IsKeyJustPressed(ebiten.KeyHome):
+		t.moveCursor(0)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnd):
+		t.moveCursor(len(t.order) - 1)
+	case ctrlPressed && inpututil.IsKeyJustPressed(ebiten.KeyA):
+		t.SelectAll()
+	}
+}
+
+// moveCursor moves the keyboard cursor to the given display row, updating the selection
+func (t *DataTable) moveCursor(row int) {
+	row = int(clamp(float64(row), 0, float64(len(t.order)-1)))
+	shiftPressed := ebiten.IsKeyPressed(ebiten.KeyShift)
+
+	t.cursorRow = row
+	switch {
+	case t.selectionMode == SelectionMultiple && shiftPressed && t.anchorRow != -1:
+		t.selectRange(t.anchorRow, row)
+	case t.selectionMode != SelectionNone:
+		t.anchorRow = row
+		t.selected = map[int]bool{t.order[row]: true}
+		t.notifySelectionChange()
+	}
+	t.ensureRowVisible(row)
+}
+
+// clickRow applies mouse selection semantics to the given display row
+func (t *DataTable) clickRow(row int) {
+	t.cursorRow = row
+	if t.selectionMode == SelectionNone {
+		return
+	}
+
+	ctrlPressed := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	shiftPressed := ebiten.IsKeyPressed(ebiten.KeyShift)
+	index := t.order[row]
+
+	switch {
+	case t.selectionMode == SelectionMultiple && shiftPressed && t.anchorRow != -1:
+		t.selectRange(t.anchorRow, row)
+		return
+	case t.selectionMode == SelectionMultiple && ctrlPressed:
+		if t.selected[index] {
+			delete(t.selected, index)
+		} else {
+			t.selected[index] = true
+		}
+	default:
+		t.selected = map[int]bool{index: true}
+	}
+
+	t.anchorRow = row
+	t.notifySelectionChange()
+}
+
+// selectRange selects all display rows between from and to, inclusive
+func (t *DataTable) selectRange(from, to int) {
+	if from > to {
+		from, to = to, from
+	}
+	t.selected = make(map[int]bool)
+	for row := from; row <= to; row++ {
+		t.selected[t.order[row]] = true
+	}
+	t.notifySelectionChange()
+}
+
+func (t *DataTable) notifySelectionChange() {
+	if t.onSelectionChange != nil {
+		t.onSelectionChange(t.GetSelectedIndices())
+	}
+}
+
+// toggleSort cycles the sort direction of a column: ascending, descending, unsorted
+func (t *DataTable) toggleSort(column int) {
+	if column < 0 || column >= len(t.columns) || !t.columns[column].Sortable {
+		return
+	}
+
+	direction := SortAscending
+	if t.sortColumn == column {
+		switch t.sortDirection {
+		case SortAscending:
+			direction = SortDescending
+		case SortDescending:
+			direction = SortNone
+		}
+	}
+	t.SortBy(column, direction)
+}
+
+// applySort rebuilds the display order of the rows from the current sort settings
+func (t *DataTable) applySort() {
+	t.order = make([]int, len(t.rows))
+	for i := range t.rows {
+		t.order[i] = i
+	}
+
+	if t.sortDirection == SortNone || t.sortColumn < 0 || t.sortColumn >= len(t.columns) {
+		return
+	}
+
+	less := t.columns[t.sortColumn].Less
+	if less == nil {
+		less = compareCellValues
+	}
+
+	sort.SliceStable(t.order, func(i, j int) bool {
+		a := t.cellValue(t.order[i], t.sortColumn)
+		b := t.cellValue(t.order[j], t.sortColumn)
+		if t.sortDirection == SortDescending {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
+
+// compareCellValues is the default comparison used when sorting columns
+func compareCellValues(a, b any) bool {
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			return af < bf
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func (t *DataTable) cellValue(row, column int) any {
+	if column >= len(t.rows[row]) {
+		return nil
+	}
+	return t.rows[row][column]
+}
+
+func (t *DataTable) Draw(screen *ebiten.Image) {
+	if t.IsHidden() {
+		return
+	}
+
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+
+	if t.isFocused {
+		// Draw the focus border 1px
+		focusBorder := GetCache().BorderImageWithColor(int(size.Width+2), int(size.Height+2), t.colors.FocusBorder)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-1, pos.Y-1)
+		screen.DrawImage(focusBorder, op)
+	}
+
+	// Draw the table's background and debug info
+	t.BaseComponent.Draw(screen)
+
+	t.drawRows(screen)
+	t.drawHeader(screen)
+
+	if t.needsScrollBar() {
+		t.drawScrollBar(screen)
+	}
+}
+
+func (t *DataTable) drawRows(screen *ebiten.Image) {
+	bounds := t.getBodyBounds()
+	if bounds.Empty() {
+		return
+	}
+	body := screen.SubImage(bounds).(*ebiten.Image)
+
+	pos := t.GetAbsolutePosition()
+	rowWidth := bounds.Dx()
+	first := int(t.scrollOffset.Y / t.rowHeight)
+	last := int(math.Ceil((t.scrollOffset.Y + t.getViewportHeight()) / t.rowHeight))
+	if last > len(t.order)-1 {
+		last = len(t.order) - 1
+	}
+
+	for row := first; row <= last; row++ {
+		index := t.order[row]
+		rowY := pos.Y + t.headerHeight + float64(row)*t.rowHeight - t.scrollOffset.Y
+
+		// Row background
+		var rowColor color.Color
+		switch {
+		case t.selected[index]:
+			rowColor = t.colors.RowSelected
+		case row == t.hoveredRow:
+			rowColor = t.colors.RowHovered
+		case row%2 == 1:
+			rowColor = t.colors.RowAlt
+		}
+		if rowColor != nil {
+			rowImg := GetCache().ImageWithColor(rowWidth, int(t.rowHeight), rowColor)
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(float64(bounds.Min.X), rowY)
+			body.DrawImage(rowImg, op)
+		}
+
+		// Cells
+		cellX := pos.X - t.scrollOffset.X
+		for column, col := range t.columns {
+			cellBounds := image.Rect(int(cellX), int(rowY), int(cellX+col.Width), int(rowY+t.rowHeight))
+			clip := cellBounds.Intersect(bounds)
+			cellX += col.Width
+			if clip.Empty() {
+				continue
+			}
+
+			renderer := col.Renderer
+			if renderer == nil {
+				renderer = t.drawCellText
+			}
+			renderer(screen.SubImage(clip).(*ebiten.Image), t.cellValue(index, column), cellBounds, t.colors.Text)
+		}
+	}
+}
+
+// drawCellText is the default cell renderer which draws the value as text
+func (t *DataTable) drawCellText(screen *ebiten.Image, value any, bounds image.Rectangle, textColor color.Color) {
+	if value == nil {
+		return
+	}
+	metrics := t.font.Metrics()
+	textY := float64(bounds.Min.Y) + (float64(bounds.Dy())-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil())
+	text.Draw(screen, fmt.Sprint(value), t.font, bounds.Min.X+int(t.cellPadding), int(textY), textColor)
+}
+
+func (t *DataTable) drawHeader(screen *ebiten.Image) {
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+
+	headerImg := GetCache().ImageWithColor(int(size.Width), int(t.headerHeight), t.colors.Header)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(headerImg, op)
+
+	headerBounds := image.Rect(int(pos.X), int(pos.Y), int(pos.X+size.Width), int(pos.Y+t.headerHeight))
+	header := screen.SubImage(headerBounds).(*ebiten.Image)
+
+	metrics := t.font.Metrics()
+	textY := pos.Y + (t.headerHeight-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil())
+
+	cellX := pos.X - t.scrollOffset.X
+	for column, col := range t.columns {
+		cellBounds := image.Rect(int(cellX), int(pos.Y), int(cellX+col.Width), int(pos.Y+t.headerHeight))
+		clip := cellBounds.Intersect(headerBounds)
+		if !clip.Empty() {
+			cell := screen.SubImage(clip).(*ebiten.Image)
+			text.Draw(cell, col.Title, t.font, int(cellX+t.cellPadding), int(textY), t.colors.HeaderText)
+
+			// Sort indicator
+			if column == t.sortColumn && t.sortDirection != SortNone {
+				dir := DirectionUp
+				if t.sortDirection == SortDescending {
+					dir = DirectionDown
+				}
+				drawArrow(cell, cellX+col.Width-t.cellPadding-4, pos.Y+t.headerHeight/2, 8, dir, t.colors.HeaderText)
+			}
+		}
+
+		cellX += col.Width
+
+		// Column divider
+		divider := GetCache().ImageWithColor(1, int(t.headerHeight), t.colors.GridLine)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(cellX-1, pos.Y)
+		header.DrawImage(divider, op)
+	}
+
+	// Bottom border of the header
+	line := GetCache().ImageWithColor(int(size.Width), 1, t.colors.GridLine)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y+t.headerHeight-1)
+	screen.DrawImage(line, op)
+}
+
+func (t *DataTable) drawScrollBar(screen *ebiten.Image) {
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+	trackX := pos.X + size.Width - t.scrollBarWidth
+	trackY := pos.Y + t.headerHeight
+
+	trackImg := GetCache().ImageWithColor(int(t.scrollBarWidth), int(t.getViewportHeight()), t.colors.Track)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(trackX, trackY)
+	screen.DrawImage(trackImg, op)
+
+	thumbColor := t.colors.Thumb
+	if t.isDraggingThumb {
+		thumbColor = t.colors.ThumbDrag
+	}
+	thumbImg := GetCache().ImageWithColor(int(t.scrollBarWidth), int(t.getScrollThumbHeight()), thumbColor)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(trackX, trackY+t.getScrollThumbPosition())
+	screen.DrawImage(thumbImg, op)
+}
+
+// getBodyBounds returns the visible rectangle of the table rows
+func (t *DataTable) getBodyBounds() image.Rectangle {
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+
+	scrollBarAdjustment := float64(0)
+	if t.needsScrollBar() {
+		scrollBarAdjustment = t.scrollBarWidth
+	}
+
+	return image.Rect(
+		int(pos.X),
+		int(pos.Y+t.headerHeight),
+		int(pos.X+size.Width-scrollBarAdjustment),
+		int(pos.Y+size.Height),
+	)
+}
+
+func (t *DataTable) getViewportHeight() float64 {
+	return math.Max(0, t.GetSize().Height-t.headerHeight)
+}
+
+func (t *DataTable) getContentHeight() float64 {
+	return float64(len(t.order)) * t.rowHeight
+}
+
+func (t *DataTable) getMaxScroll() float64 {
+	return math.Max(0, t.getContentHeight()-t.getViewportHeight())
+}
+
+// getViewportWidth returns the width the columns are shown in, beside the scroll bar
+func (t *DataTable) getViewportWidth() float64 {
+	return float64(t.getBodyBounds().Dx())
+}
+
+// getContentWidth returns the total width of the columns
+func (t *DataTable) getContentWidth() float64 {
+	width := 0.0
+	for _, col := range t.columns {
+		width += col.Width
+	}
+	return width
+}
+
+// getMaxScrollX returns how far the columns can be scrolled horizontally
+func (t *DataTable) getMaxScrollX() float64 {
+	return math.Max(0, t.getContentWidth()-t.getViewportWidth())
+}
+
+func (t *DataTable) needsScrollBar() bool {
+	return !t.isScrollBarHidden && t.getContentHeight() > t.getViewportHeight()
+}
+
+func (t *DataTable) getScrollThumbHeight() float64 {
+	viewportHeight := t.getViewportHeight()
+	contentHeight := t.getContentHeight()
+	if contentHeight <= 0 {
+		return viewportHeight
+	}
+	return math.Min(viewportHeight, math.Max(viewportHeight*viewportHeight/contentHeight, 20)) // Minimum thumb size of 20px
+}
+
+func (t *DataTable) getScrollThumbPosition() float64 {
+	maxScroll := t.getMaxScroll()
+	if maxScroll <= 0 {
+		return 0
+	}
+	return (t.getViewportHeight() - t.getScrollThumbHeight()) * t.scrollOffset.Y / maxScroll
+}
+
+func (t *DataTable) isOverScrollBar(x, y float64) bool {
+	if !t.needsScrollBar() {
+		return false
+	}
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+	return x >= pos.X+size.Width-t.scrollBarWidth && x <= pos.X+size.Width &&
+		y >= pos.Y+t.headerHeight && y <= pos.Y+size.Height
+}
+
+func (t *DataTable) isOverScrollThumb(x, y float64) bool {
+	if !t.isOverScrollBar(x, y) {
+		return false
+	}
+	thumbY := t.GetAbsolutePosition().Y + t.headerHeight + t.getScrollThumbPosition()
+	return y >= thumbY && y <= thumbY+t.getScrollThumbHeight()
+}
+
+func (t *DataTable) isOverHeader(x, y float64) bool {
+	pos := t.GetAbsolutePosition()
+	return x >= pos.X && x <= pos.X+t.GetSize().Width &&
+		y >= pos.Y && y < pos.Y+t.headerHeight
+}
+
+// columnAt returns the index of the column at the given x position, or -1
+func (t *DataTable) columnAt(x float64) int {
+	cellX := t.GetAbsolutePosition().X - t.scrollOffset.X
+	for i, col := range t.columns {
+		if x >= cellX && x < cellX+col.Width {
+			return i
+		}
+		cellX += col.Width
+	}
+	return -1
+}
+
+// GetCursorAt returns a resize cursor over the column dividers
+func (t *DataTable) GetCursorAt(x, y float64) ebiten.CursorShapeType {
+	if t.resizingColumn != -1 || t.dividerAt(x, y) != -1 {
+		return ebiten.CursorShapeEWResize
+	}
+	return t.GetCursor()
+}
+
+// dividerAt returns the index of the column whose right divider is under the given point, or -1
+func (t *DataTable) dividerAt(x, y float64) int {
+	if !t.isOverHeader(x, y) {
+		return -1
+	}
+	const grabWidth = 4
+	cellX := t.GetAbsolutePosition().X - t.scrollOffset.X
+	for i, col := range t.columns {
+		cellX += col.Width
+		if math.Abs(x-cellX) <= grabWidth {
+			return i
+		}
+	}
+	return -1
+}
+
+// rowAt returns the display index of the row at the given point, or -1
+func (t *DataTable) rowAt(x, y float64) int {
+	bounds := t.getBodyBounds()
+	if x < float64(bounds.Min.X) || x >= float64(bounds.Max.X) ||
+		y < float64(bounds.Min.Y) || y >= float64(bounds.Max.Y) {
+		return -1
+	}
+	row := int((y - float64(bounds.Min.Y) + t.scrollOffset.Y) / t.rowHeight)
+	if row < 0 || row >= len(t.order) {
+		return -1
+	}
+	return row
+}
+
+// ensureRowVisible scrolls the table so that the given display row is fully visible
+func (t *DataTable) ensureRowVisible(row int) {
+	rowTop := float64(row) * t.rowHeight
+	rowBottom := rowTop + t.rowHeight
+	scrollOffset := t.GetScrollOffset()
+	if rowTop < scrollOffset.Y {
+		scrollOffset.Y = rowTop
+	} else if rowBottom > scrollOffset.Y+t.getViewportHeight() {
+		scrollOffset.Y = rowBottom - t.getViewportHeight()
+	}
+	t.SetScrollOffset(scrollOffset)
+}
+
+func (t *DataTable) IsWithinBounds(x, y float64) bool {
+	return t.Contains(x, y)
+}
+
+// Public API methods
+
+// SetColumns replaces the columns of the table and resets sorting
+func (t *DataTable) SetColumns(columns ...DataTableColumn) {
+	t.columns = columns
+	t.sortColumn = -1
+	t.sortDirection = SortNone
+	t.applySort()
+	t.clampScrollOffset()
+}
+
+// GetColumns returns the columns of the table
+func (t *DataTable) GetColumns() []DataTableColumn {
+	return t.columns
+}
+
+// SetColumnWidth sets the width of a column, respecting its minimum width
+func (t *DataTable) SetColumnWidth(column int, width float64) {
+	if column < 0 || column >= len(t.columns) {
+		return
+	}
+	minWidth := math.Max(t.columns[column].MinWidth, 2*t.cellPadding)
+	t.columns[column].Width = math.Max(width, minWidth)
+	t.clampScrollOffset()
+}
+
+// GetColumnWidth returns the width of a column
+func (t *DataTable) GetColumnWidth(column int) float64 {
+	if column < 0 || column >= len(t.columns) {
+		return 0
+	}
+	return t.columns[column].Width
+}
+
+// SetRows replaces the rows of the table, clearing the selection
+func (t *DataTable) SetRows(rows []DataTableRow) {
+	t.rows = rows
+	t.selected = make(map[int]bool)
+	t.cursorRow = -1
+	t.anchorRow = -1
+	t.hoveredRow = -1
+	t.applySort()
+	t.clampScrollOffset()
+	t.notifySelectionChange()
+}
+
+// AddRow appends a row to the table
+func (t *DataTable) AddRow(row DataTableRow) {
+	t.rows = append(t.rows, row)
+	t.applySort()
+}
+
+// GetRows returns all rows in their original order
+func (t *DataTable) GetRows() []DataTableRow {
+	return t.rows
+}
+
+// GetRow returns the row at the given original index
+func (t *DataTable) GetRow(index int) DataTableRow {
+	if index < 0 || index >= len(t.rows) {
+		return nil
+	}
+	return t.rows[index]
+}
+
+// GetRowCount returns the number of rows in the table
+func (t *DataTable) GetRowCount() int {
+	return len(t.rows)
+}
+
+// SortBy sorts the table by the given column and direction
+func (t *DataTable) SortBy(column int, direction SortDirection) {
+	t.sortColumn = column
+	t.sortDirection = direction
+	if direction == SortNone {
+		t.sortColumn = -1
+	}
+	t.applySort()
+	t.cursorRow = -1
+	t.anchorRow = -1
+
+	if t.onSort != nil {
+		t.onSort(column, direction)
+	}
+}
+
+// GetSort returns the current sort column and direction
+func (t *DataTable) GetSort() (int, SortDirection) {
+	return t.sortColumn, t.sortDirection
+}
+
+// GetSelectedIndices returns the original indices of the selected rows in ascending order
+func (t *DataTable) GetSelectedIndices() []int {
+	indices := make([]int, 0, len(t.selected))
+	for index := range t.selected {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// GetSelectedRows returns the selected rows in their original order
+func (t *DataTable) GetSelectedRows() []DataTableRow {
+	indices := t.GetSelectedIndices()
+	rows := make([]DataTableRow, len(indices))
+	for i, index := range indices {
+		rows[i] = t.rows[index]
+	}
+	return rows
+}
+
+// SetSelectedIndices selects the rows with the given original indices
+func (t *DataTable) SetSelectedIndices(indices ...int) {
+	t.selected = make(map[int]bool)
+	for _, index := range indices {
+		if index >= 0 && index < len(t.rows) {
+			t.selected[index] = true
+		}
+		if t.selectionMode == SelectionSingle {
+			break
+		}
+	}
+	t.notifySelectionChange()
+}
+
+// SelectAll selects every row if multiple selection is enabled
+func (t *DataTable) SelectAll() {
+	if t.selectionMode != SelectionMultiple {
+		return
+	}
+	t.selected = make(map[int]bool)
+	for i := range t.rows {
+		t.selected[i] = true
+	}
+	t.notifySelectionChange()
+}
+
+// ClearSelection deselects all rows
+func (t *DataTable) ClearSelection() {
+	t.selected = make(map[int]bool)
+	t.anchorRow = -1
+	t.notifySelectionChange()
+}
+
+// SetColors sets the color scheme for the table
+func (t *DataTable) SetColors(colors DataTableColors) {
+	t.colors = colors
+	t.SetBackground(colors.Background)
+}
+
+func (t *DataTable) GetScrollOffset() Position {
+	return t.scrollOffset
+}
+
+func (t *DataTable) SetScrollOffset(offset Position) {
+	t.scrollOffset = offset
+	t.clampScrollOffset()
+
+	// The pointer is over a different row once the rows scroll beneath it
+	if t.isHovered {
+		t.hoveredRow = t.rowAt(t.pointerX, t.pointerY)
+	}
+}
+
+// clampScrollOffset keeps the rows and columns from scrolling past their ends
+func (t *DataTable) clampScrollOffset() {
+	t.scrollOffset.X = clamp(t.scrollOffset.X, 0, t.getMaxScrollX())
+	t.scrollOffset.Y = clamp(t.scrollOffset.Y, 0, t.getMaxScroll())
+}
+
+func (t *DataTable) ScrollToTop() {
+	t.SetScrollOffset(Position{X: t.scrollOffset.X, Y: 0})
+}
+
+func (t *DataTable) ScrollToBottom() {
+	t.SetScrollOffset(Position{X: t.scrollOffset.X, Y: t.getMaxScroll()})
+}
+
+func (t *DataTable) HideScrollBar() {
+	t.isScrollBarHidden = true
+}
+
+func (t *DataTable) ShowScrollBar() {
+	t.isScrollBarHidden = false
+}
+
+func (t *DataTable) IsScrollBarHidden() bool {
+	return t.isScrollBarHidden
+}
@@ -0,0 +1,154 @@
+package ebui
+
+import "math"
+
+const (
+	dialogWidth        = 320
+	dialogPadding      = 12
+	dialogSpacing      = 10
+	dialogButtonWidth  = 80
+	dialogButtonHeight = 28
+	dialogInputHeight  = 28
+)
+
+// MessageBox shows a modal dialog with a message and an OK button.
+// onClose is called when the dialog is closed and may be nil.
+func (wm *WindowManager) MessageBox(title, message string, onClose func()) *Window {
+	var window *Window
+	window = wm.showDialog(title, message, nil, onClose,
+		newDialogButton("OK", func() { window.Hide() }),
+	)
+	return window
+}
+
+// ConfirmDialog shows a modal dialog with a message and OK and Cancel buttons.
+// onResult receives true if OK was pressed, and false if the dialog was cancelled or closed.
+func (wm *WindowManager) ConfirmDialog(title, message string, onResult func(confirmed bool)) *Window {
+	confirmed := false
+	var window *Window
+	window = wm.showDialog(title, message, nil,
+		func() {
+			if onResult != nil {
+				onResult(confirmed)
+			}
+		},
+		newDialogButton("OK", func() {
+			confirmed = true
+			window.Hide()
+		}),
+		newDialogButton("Cancel", func() { window.Hide() }),
+	)
+	return window
+}
+
+// PromptDialog shows a modal dialog asking for a line of text, starting with the given value.
+// Extra options such as WithValidator or WithMaxLength configure the text input.
+// onResult receives the entered text and true if it was submitted, or false if the dialog was cancelled or closed.
+func (wm *WindowManager) PromptDialog(title, message, value string, onResult func(value string, ok bool), opts ...ComponentOpt) *Window {
+	submitted := false
+	var window *Window
+
+	var input *TextInput
+	submit := func() {
+		if !input.Validate() {
+			return
+		}
+		submitted = true
+		window.Hide()
+	}
+	input = NewTextInput(append([]ComponentOpt{
+		WithSize(dialogWidth-dialogPadding*2, dialogInputHeight),
+		WithInitialText(value),
+		WithSubmitHandler(func(string) { submit() }),
+	}, opts...)...)
+
+	window = wm.showDialog(title, message, input,
+		func() {
+			if onResult != nil {
+				onResult(input.GetText(), submitted)
+			}
+		},
+		newDialogButton("OK", submit),
+		newDialogButton("Cancel", func() { window.Hide() }),
+	)
+	return window
+}
+
+func newDialogButton(text string, onClick func()) *Button {
+	return NewButton(
+		WithSize(dialogButtonWidth, dialogButtonHeight),
+		WithLabelText(text),
+		WithClickHandler(onClick),
+	)
+}
+
+// showDialog builds a dialog window with a message, an optional text input and a row of buttons,
+// then shows it centered as a modal. The dialog is removed from the window manager once closed.
+func (wm *WindowManager) showDialog(title, message string, input *TextInput, onClose func(), buttons ...*Button) *Window {
+	innerWidth := float64(dialogWidth - dialogPadding*2)
+
+	label := NewLabel(message,
+		WithSize(innerWidth, 1),
+		WithJustify(JustifyLeft),
+		WithTextWrap(),
+	)
+	label.SetSize(Size{Width: innerWidth, Height: float64(label.GetTextHeight())})
+
+	buttonRow := NewLayoutContainer(
+		WithSize(innerWidth, dialogButtonHeight),
+		WithLayout(NewHorizontalStackLayout(8, AlignEnd)),
+	)
+
+	// The input and buttons come first in the tab order, so the dialog opens with them focused
+	tabIndex := -len(buttons)
+	if input != nil {
+		tabIndex--
+		input.SetTabIndex(tabIndex)
+		tabIndex++
+	}
+	for _, button := range buttons {
+		button.SetTabIndex(tabIndex)
+		tabIndex++
+		buttonRow.AddChild(button)
+	}
+
+	bodyHeight := dialogPadding*2 + label.GetSize().Height + dialogSpacing + dialogButtonHeight
+	if input != nil {
+		bodyHeight += dialogSpacing + dialogInputHeight
+	}
+
+	var window *Window
+	window = wm.CreateWindow(dialogWidth, bodyHeight,
+		WithWindowTitle(title),
+		WithCloseCallback(func() {
+			wm.RemoveChild(window)
+			if onClose != nil {
+				onClose()
+			}
+		}),
+	)
+	window.SetSize(Size{Width: dialogWidth, Height: window.headerHeight + bodyHeight})
+
+	body := NewLayoutContainer(
+		WithSize(dialogWidth, bodyHeight),
+		WithPadding(dialogPadding, dialogPadding, dialogPadding, dialogPadding),
+		WithLayout(NewVerticalStackLayout(dialogSpacing, AlignStart)),
+	)
+	body.AddChild(label)
+	if input != nil {
+		body.AddChild(input)
+	}
+	body.AddChild(buttonRow)
+	window.AddChild(body)
+
+	// Center the dialog in the window manager
+	bounds := wm.GetSize()
+	size := window.GetSize()
+	pos := window.GetPosition()
+	pos.X = math.Max(0, (bounds.Width-size.Width)/2)
+	pos.Y = math.Max(0, (bounds.Height-size.Height)/2)
+	window.SetPosition(pos)
+
+	wm.ShowModal(window)
+	return window
+}
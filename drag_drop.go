@@ -0,0 +1,301 @@
+package ebui
+
+import (
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font/basicfont"
+)
+
+// DropEffect describes what happens to dragged data when it is dropped
+type DropEffect int
+
+const (
+	DropEffectNone DropEffect = iota // The data cannot be dropped here
+	DropEffectMove                   // The data is moved to the target
+	DropEffectCopy                   // A copy of the data is added to the target
+	DropEffectLink                   // The target refers to the data
+)
+
+// DragData is the payload of a drag-and-drop operation.
+// Set it on the event in a DragStart handler to start a drag carrying data:
+//
+//	slot.AddEventListener(ebui.DragStart, func(e *ebui.Event) {
+//		e.DragData = &ebui.DragData{Kind: "inventory/item", Value: slot.item}
+//	})
+type DragData struct {
+	// Kind is a MIME-like type such as "inventory/item" that drop targets use to decide whether to accept the data
+	Kind string
+	// Value is the dragged value
+	Value any
+	// Ghost is drawn semi-transparently under the cursor while dragging.
+	// If nil, an image of the component the drag started on is used.
+	Ghost *ebiten.Image
+	// GhostOffsetX and GhostOffsetY position the ghost relative to the cursor.
+	// If both are zero the ghost keeps the offset from where the component was grabbed.
+	GhostOffsetX, GhostOffsetY float64
+}
+
+// DropTarget is implemented by components that accept dropped data.
+// While data is dragged over a drop target it receives DragEnter, DragOver and DragLeave events,
+// and Drop if it returns an effect other than DropEffectNone for the data.
+type DropTarget interface {
+	GetDropEffect(data *DragData) DropEffect
+}
+
+var _ DropTarget = &BaseDropTarget{}
+
+// BaseDropTarget is a base struct that implements the DropTarget interface by accepting a set of kinds.
+// Kinds may end in "/*" to accept every kind with that prefix, or be "*" to accept everything.
+type BaseDropTarget struct {
+	acceptedKinds []string
+	effect        DropEffect
+}
+
+// NewBaseDropTarget creates a drop target that accepts the given kinds with the given effect
+func NewBaseDropTarget(effect DropEffect, kinds ...string) *BaseDropTarget {
+	return &BaseDropTarget{
+		acceptedKinds: kinds,
+		effect:        effect,
+	}
+}
+
+// GetDropEffect returns the drop effect if the data is of an accepted kind, otherwise DropEffectNone
+func (b *BaseDropTarget) GetDropEffect(data *DragData) DropEffect {
+	if data != nil && b.AcceptsKind(data.Kind) {
+		return b.effect
+	}
+	return DropEffectNone
+}
+
+// AcceptsKind returns whether data of the kind can be dropped on the target
+func (b *BaseDropTarget) AcceptsKind(kind string) bool {
+	for _, accepted := range b.acceptedKinds {
+		if accepted == "*" || accepted == kind {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(accepted, "*"); ok && strings.HasPrefix(kind, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetAcceptedKinds sets the kinds of data the target accepts
+func (b *BaseDropTarget) SetAcceptedKinds(kinds ...string) {
+	b.acceptedKinds = kinds
+}
+
+// GetAcceptedKinds returns the kinds of data the target accepts
+func (b *BaseDropTarget) GetAcceptedKinds() []string {
+	return b.acceptedKinds
+}
+
+// SetDropEffect sets the effect of dropping accepted data on the target
+func (b *BaseDropTarget) SetDropEffect(effect DropEffect) {
+	b.effect = effect
+}
+
+// findDropTarget returns the innermost drop target in an event path and the path leading to it
+func findDropTarget(path []InteractiveComponent) (InteractiveComponent, []InteractiveComponent) {
+	for i := len(path) - 1; i >= 0; i-- {
+		if _, ok := path[i].(DropTarget); ok {
+			return path[i], path[:i+1]
+		}
+	}
+	return nil, nil
+}
+
+// captureComponent renders a component into a new image the size of the component
+func captureComponent(c Component) *ebiten.Image {
+	pos := c.GetAbsolutePosition()
+	size := c.GetSize()
+	if !size.IsDrawable() || pos.X+size.Width <= 0 || pos.Y+size.Height <= 0 {
+		return nil
+	}
+
+	// Components draw at their absolute position, so draw onto a canvas reaching that far and crop
+	canvas := ebiten.NewImage(int(pos.X+size.Width)+1, int(pos.Y+size.Height)+1)
+	defer canvas.Deallocate()
+	c.Draw(canvas)
+
+	image := ebiten.NewImage(int(size.Width), int(size.Height))
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-pos.X, -pos.Y)
+	image.DrawImage(canvas, op)
+	return image
+}
+
+// startDrag records the payload set by DragStart handlers and prepares its ghost
+func (im *InputManager) startDrag(data *DragData, x, y float64) {
+	im.dragData = data
+	if data == nil {
+		return
+	}
+
+	im.dragGhost = data.Ghost
+	if im.dragGhost == nil {
+		im.dragGhost = captureComponent(im.dragSource)
+		im.ownsDragGhost = im.dragGhost != nil
+	}
+
+	im.dragGhostX, im.dragGhostY = data.GhostOffsetX, data.GhostOffsetY
+	if im.dragGhostX == 0 && im.dragGhostY == 0 {
+		pos := im.dragSource.GetAbsolutePosition()
+		im.dragGhostX, im.dragGhostY = pos.X-x, pos.Y-y
+	}
+}
+
+// updateDropTarget sends DragLeave and DragEnter as the payload moves between drop targets,
+// and DragOver to the drop target under the cursor
+func (im *InputManager) updateDropTarget(baseEvent Event) {
+	target, path := findDropTarget(baseEvent.Path)
+
+	if target != im.dropTarget {
+		if im.dropTarget != nil {
+			im.dispatchDropTargetEvent(baseEvent, DragLeave)
+		}
+
+		im.dropTarget = target
+		im.dropTargetPath = path
+		im.dropEffect = DropEffectNone
+
+		if target != nil {
+			im.dropEffect = target.(DropTarget).GetDropEffect(im.dragData)
+			im.dispatchDropTargetEvent(baseEvent, DragEnter)
+		}
+	}
+
+	if im.dropTarget != nil {
+		// Targets may change what they accept during the drag
+		im.dropEffect = im.dropTarget.(DropTarget).GetDropEffect(im.dragData)
+		im.dispatchDropTargetEvent(baseEvent, DragOver)
+	}
+}
+
+// dispatchDropTargetEvent dispatches an event carrying the payload to the current drop target
+func (im *InputManager) dispatchDropTargetEvent(baseEvent Event, eventType EventType) {
+	event := baseEvent
+	event.Type = eventType
+	event.Target = im.dropTarget
+	event.Path = im.dropTargetPath
+	event.RelatedTarget = im.dragSource
+	event.DragData = im.dragData
+	event.DropEffect = im.dropEffect
+	im.dispatchEvent(&event)
+}
+
+// endDrag drops the payload on the drop target under the cursor if it accepts it,
+// then sends DragEnd to the drag source with the resulting drop effect
+func (im *InputManager) endDrag(baseEvent Event) {
+	im.updateDropTarget(baseEvent)
+
+	dragEndEvent := baseEvent
+	dragEndEvent.Type = DragEnd
+	dragEndEvent.Target = im.dragSource
+	dragEndEvent.DragData = im.dragData
+
+	if im.dropTarget != nil && im.dropEffect != DropEffectNone {
+		im.dispatchDropTargetEvent(baseEvent, Drop)
+		dragEndEvent.RelatedTarget = im.dropTarget
+		dragEndEvent.DropEffect = im.dropEffect
+	} else if im.dropTarget != nil {
+		im.dispatchDropTargetEvent(baseEvent, DragLeave)
+	}
+
+	im.dispatchEvent(&dragEndEvent)
+	im.resetDrag()
+}
+
+// cancelDrag ends the drag without dropping. The drag source receives DragEnd with Cancelled set and DropEffectNone.
+func (im *InputManager) cancelDrag(baseEvent Event) {
+	if im.dropTarget != nil {
+		im.dropEffect = DropEffectNone
+		im.dispatchDropTargetEvent(baseEvent, DragLeave)
+	}
+
+	dragEndEvent := baseEvent
+	dragEndEvent.Type = DragEnd
+	dragEndEvent.Target = im.dragSource
+	dragEndEvent.DragData = im.dragData
+	dragEndEvent.Cancelled = true
+	im.dispatchEvent(&dragEndEvent)
+
+	im.resetDrag()
+}
+
+// resetDrag clears the state of the current drag
+func (im *InputManager) resetDrag() {
+	if im.ownsDragGhost {
+		im.dragGhost.Deallocate()
+	}
+
+	im.isDragging = false
+	im.dragSource = nil
+	im.dragData = nil
+	im.dragGhost = nil
+	im.ownsDragGhost = false
+	im.dropTarget = nil
+	im.dropTargetPath = nil
+	im.dropEffect = DropEffectNone
+}
+
+// IsDragging returns whether a drag carrying a payload is in progress
+func (im *InputManager) IsDragging() bool {
+	return im.isDragging && im.dragData != nil
+}
+
+// GetDragData returns the payload of the current drag, or nil if there is none
+func (im *InputManager) GetDragData() *DragData {
+	return im.dragData
+}
+
+// Draw draws the ghost of the dragged payload above the rest of the UI.
+// The ghost is dimmed and tinted red while it is over a location that does not accept it.
+func (im *InputManager) Draw(screen *ebiten.Image) {
+	if im.dragData == nil {
+		return
+	}
+
+	if im.dragGhost != nil {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(im.lastMouseX+im.dragGhostX, im.lastMouseY+im.dragGhostY)
+		if im.dropEffect == DropEffectNone {
+			op.ColorScale.Scale(1, 0.5, 0.5, 1)
+			op.ColorScale.ScaleAlpha(0.35)
+		} else {
+			op.ColorScale.ScaleAlpha(0.7)
+		}
+		screen.DrawImage(im.dragGhost, op)
+	}
+
+	drawDropEffectBadge(screen, im.lastMouseX+10, im.lastMouseY+10, im.dropEffect)
+}
+
+// drawDropEffectBadge draws a small badge next to the cursor indicating the drop effect
+func drawDropEffectBadge(screen *ebiten.Image, x, y float64, effect DropEffect) {
+	var symbol string
+	var col color.Color
+	switch effect {
+	case DropEffectNone:
+		symbol, col = "x", color.RGBA{200, 60, 60, 230}
+	case DropEffectCopy:
+		symbol, col = "+", color.RGBA{60, 160, 80, 230}
+	case DropEffectLink:
+		symbol, col = "@", color.RGBA{60, 110, 200, 230}
+	default:
+		return
+	}
+
+	size := 13
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x, y)
+	screen.DrawImage(GetCache().ImageWithColor(size, size, col), op)
+
+	metrics := basicfont.Face7x13.Metrics()
+	textX := int(x) + (size-7)/2
+	textY := int(y) + (size-metrics.Height.Ceil())/2 + metrics.Ascent.Ceil()
+	text.Draw(screen, symbol, basicfont.Face7x13, textX, textY, color.White)
+}
@@ -0,0 +1,52 @@
+package ebui
+
+import "testing"
+
+func TestBaseDropTargetAcceptsKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		accepted []string
+		kind     string
+		want     bool
+	}{
+		{name: "exact kind", accepted: []string{"inventory/item"}, kind: "inventory/item", want: true},
+		{name: "other kind", accepted: []string{"inventory/item"}, kind: "inventory/weapon", want: false},
+		{name: "prefix", accepted: []string{"inventory/*"}, kind: "inventory/weapon", want: true},
+		{name: "other prefix", accepted: []string{"inventory/*"}, kind: "equipment/helmet", want: false},
+		{name: "prefix includes the slash", accepted: []string{"inventory/*"}, kind: "inventory", want: false},
+		{name: "everything", accepted: []string{"*"}, kind: "anything", want: true},
+		{name: "nothing accepted", accepted: nil, kind: "inventory/item", want: false},
+		{name: "any of several", accepted: []string{"inventory/item", "equipment/*"}, kind: "equipment/helmet", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := NewBaseDropTarget(DropEffectMove, tt.accepted...)
+			if got := target.AcceptsKind(tt.kind); got != tt.want {
+				t.Errorf("AcceptsKind(%q) with %v = %v, want %v", tt.kind, tt.accepted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaseDropTargetGetDropEffect(t *testing.T) {
+	target := NewBaseDropTarget(DropEffectCopy, "inventory/*")
+
+	tests := []struct {
+		name string
+		data *DragData
+		want DropEffect
+	}{
+		{name: "no data", data: nil, want: DropEffectNone},
+		{name: "accepted kind", data: &DragData{Kind: "inventory/item"}, want: DropEffectCopy},
+		{name: "other kind", data: &DragData{Kind: "equipment/helmet"}, want: DropEffectNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := target.GetDropEffect(tt.data); got != tt.want {
+				t.Errorf("GetDropEffect(%+v) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
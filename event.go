@@ -17,10 +17,20 @@ const (
 	DragStart  EventType = "dragstart"
 	Drag       EventType = "drag"
 	DragOver   EventType = "dragover"
+	DragEnter  EventType = "dragenter"
+	DragLeave  EventType = "dragleave"
 	DragEnd    EventType = "dragend"
 	Drop       EventType = "drop"
 	Focus      EventType = "focus"
 	Blur       EventType = "blur"
+
+	// LostPointerCapture is sent to a component when it loses the pointer capture
+	LostPointerCapture EventType = "lostpointercapture"
+
+	// WindowActivated and WindowDeactivated are sent to a window when it becomes or stops being the active window.
+	// RelatedTarget is the window that stopped or became active, if any.
+	WindowActivated   EventType = "windowactivated"
+	WindowDeactivated EventType = "windowdeactivated"
 )
 
 type EventPhase int
@@ -45,6 +55,11 @@ type Event struct {
 	Bubbles                  bool
 	Phase                    EventPhase
 	Path                     []InteractiveComponent
+	DragData                 *DragData
+	DropEffect               DropEffect
+	Cancelled                bool // Whether a DragEnd is for a drag cancelled with Esc rather than released
+
+	inputManager *InputManager
 }
 
 // EventBoundary represents a component that controls event propagation
@@ -77,10 +77,7 @@ func NewInventoryGame() *InventoryGame {
 // functionality.
 type Inventory struct {
 	*ebui.ScrollableContainer
-	slots         []*InventorySlot
-	draggedItem   *Item
-	dragStartSlot *InventorySlot
-	dragX, dragY  float64
+	slots []*InventorySlot
 }
 
 func WithNumSlots(n int) ebui.ComponentOpt {
@@ -138,80 +135,22 @@ func (inv *Inventory) isWithinInventory(x, y float64) bool {
 	return inv.BaseComponent.Contains(x, y)
 }
 
-func (inv *Inventory) startDragging(slot *InventorySlot, mouseX, mouseY float64) {
-	inv.draggedItem = slot.item
-	inv.dragStartSlot = slot
-	inv.dragX = mouseX - 32 // Center the item on cursor
-	inv.dragY = mouseY - 32
-}
-
-func (inv *Inventory) updateDragPosition(mouseX, mouseY float64) {
-	inv.dragX = mouseX - 32
-	inv.dragY = mouseY - 32
-}
-
-func (inv *Inventory) endDragging() {
-	inv.draggedItem = nil
-	inv.dragStartSlot = nil
-}
-
-func (inv *Inventory) Draw(screen *ebiten.Image) {
-	// Draw the scrollable container
-	inv.ScrollableContainer.Draw(screen)
-
-	// Draw the dragged item if there is one
-	if inv.draggedItem != nil {
-		inv.drawDraggedItem(screen)
-	}
-}
-
-func (inv *Inventory) drawDraggedItem(screen *ebiten.Image) {
-	// Draw the dragged item with a semi-transparent background
-	transparency := 0.7
-	if !inv.isWithinInventory(inv.dragX+32, inv.dragY+32) {
-		// If the item is outside the inventory bounds, increase transparency
-		transparency = 0.4
-	}
-
-	r, g, b, a := inv.draggedItem.Color.RGBA()
-	background := color.RGBA{
-		uint8(float64(r>>8) * transparency),
-		uint8(float64(g>>8) * transparency),
-		uint8(float64(b>>8) * transparency),
-		uint8(float64(a>>8) * transparency),
-	}
-
-	draggedSlot := ebui.NewLayoutContainer(
-		ebui.WithSize(64, 64),
-		ebui.WithPosition(ebui.Position{X: inv.dragX, Y: inv.dragY}),
-		ebui.WithBackground(background),
-	)
-
-	// Add the item name label
-	nameLbl := ebui.NewLabel(
-		inv.draggedItem.Name,
-		ebui.WithSize(64, 64),
-		ebui.WithPosition(ebui.Position{X: inv.dragX, Y: inv.dragY}),
-		ebui.WithJustify(ebui.JustifyCenter),
-	)
-	draggedSlot.AddChild(nameLbl)
-
-	// Draw the dragged item
-	draggedSlot.Draw(screen)
-}
-
 // InventorySlot is a custom component representing a slot in an inventory.
-// It embeds the LayoutContainer and BaseFocusable components and adds
-// inventory slot-specific functionality.
+// It embeds the LayoutContainer, BaseFocusable and BaseDropTarget components
+// and adds inventory slot-specific functionality.
 type InventorySlot struct {
 	*ebui.LayoutContainer
 	*ebui.BaseFocusable
+	*ebui.BaseDropTarget
 	item      *Item
 	label     *ebui.Label
 	isHovered bool
 	inv       *Inventory
 }
 
+// itemKind is the drag data kind of inventory items
+const itemKind = "inventory/item"
+
 type Item struct {
 	Name  string
 	Color color.Color
@@ -224,8 +163,9 @@ func NewInventorySlot(inv *Inventory) *InventorySlot {
 			ebui.WithBackground(color.RGBA{200, 200, 200, 255}),
 			ebui.WithLayout(ebui.NewVerticalStackLayout(0, ebui.AlignCenter)),
 		),
-		BaseFocusable: ebui.NewBaseFocusable(),
-		inv:           inv,
+		BaseFocusable:  ebui.NewBaseFocusable(),
+		BaseDropTarget: ebui.NewBaseDropTarget(ebui.DropEffectMove, itemKind),
+		inv:            inv,
 	}
 
 	slot.label = ebui.NewLabel(
@@ -249,37 +189,32 @@ func (s *InventorySlot) registerEventListeners() {
 	})
 
 	s.AddEventListener(ebui.DragStart, func(e *ebui.Event) {
-		if s.item != nil {
-			s.inv.startDragging(s, e.MouseX, e.MouseY)
+		if s.item != nil && e.MouseButton == ebiten.MouseButtonLeft {
+			e.DragData = &ebui.DragData{
+				Kind:         itemKind,
+				Value:        s.item,
+				GhostOffsetX: -32, // Center the item on cursor
+				GhostOffsetY: -32,
+			}
 		}
 	})
 
-	s.AddEventListener(ebui.Drag, func(e *ebui.Event) {
-		s.inv.updateDragPosition(e.MouseX, e.MouseY)
-	})
-
 	s.AddEventListener(ebui.Drop, func(e *ebui.Event) {
 		if sourceSlot, ok := e.RelatedTarget.(*InventorySlot); ok {
 			// Swap items between slots
 			s.item, sourceSlot.item = sourceSlot.item, s.item
 			s.updateDisplay()
 			sourceSlot.updateDisplay()
-			s.inv.endDragging()
 		}
 	})
 
 	s.AddEventListener(ebui.DragEnd, func(e *ebui.Event) {
-		if s.inv.draggedItem != nil {
-			// Check if the mouse is within the inventory bounds
-			if s.inv.isWithinInventory(e.MouseX, e.MouseY) {
-				// Do nothing, item will return to original slot
-				s.inv.endDragging()
-			} else {
-				// Remove the item from the source slot
-				s.inv.dragStartSlot.item = nil
-				s.inv.dragStartSlot.updateDisplay()
-				s.inv.endDragging()
-			}
+		if e.DragData == nil || e.Cancelled || e.DropEffect != ebui.DropEffectNone {
+			return
+		}
+		// Remove the item when it is released outside the inventory
+		if !s.inv.isWithinInventory(e.MouseX, e.MouseY) {
+			s.SetItem(nil)
 		}
 	})
 }
@@ -40,10 +40,115 @@ func (b *BaseFocusable) SetTabIndex(index int) {
 	b.tabIndex = index
 }
 
+// InputTrap is implemented by containers that can confine input to one of their descendants,
+// such as a window manager showing a modal window. While a trap is active, components outside
+// of it receive no pointer input and cannot be focused.
+type InputTrap interface {
+	GetInputTrap() Component
+}
+
+// findInputTrap returns the innermost active input trap in the tree, or nil if there is none
+func findInputTrap(root Component) Component {
+	var trap Component
+	var search func(Component)
+	search = func(c Component) {
+		if c.IsDisabled() {
+			return
+		}
+		if it, ok := c.(InputTrap); ok {
+			if t := it.GetInputTrap(); t != nil {
+				trap = t
+				// Look for a nested trap inside the active one
+				if t != c {
+					search(t)
+				}
+				return
+			}
+		}
+		if container, ok := c.(Container); ok {
+			for _, child := range container.GetChildren() {
+				search(child)
+				if trap != nil {
+					return
+				}
+			}
+		}
+	}
+	search(root)
+	return trap
+}
+
+// containsComponent returns whether target is root or one of its descendants
+func containsComponent(root Component, target Component) bool {
+	if root == target {
+		return true
+	}
+	if container, ok := root.(Container); ok {
+		for _, child := range container.GetChildren() {
+			if containsComponent(child, target) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// KeyboardCapture is implemented by components that read the keyboard themselves while they are active,
+// such as a menu manager with an open menu. While a component captures the keyboard, the focused component
+// is blurred so it does not handle the same keys, and it is focused again afterwards.
+type KeyboardCapture interface {
+	CapturesKeyboard() bool
+}
+
+// findKeyboardCapture returns whether a shown component inside the active input trap captures the keyboard
+func findKeyboardCapture(root Component) bool {
+	if trap := findInputTrap(root); trap != nil {
+		root = trap
+	}
+	var search func(Component) bool
+	search = func(c Component) bool {
+		if c.IsDisabled() || c.IsHidden() {
+			return false
+		}
+		if kc, ok := c.(KeyboardCapture); ok && kc.CapturesKeyboard() {
+			return true
+		}
+		if container, ok := c.(Container); ok {
+			for _, child := range container.GetChildren() {
+				if search(child) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return search(root)
+}
+
+// isOutsideInputTrap returns whether an active input trap in the component's tree leaves the component out.
+// Components that read the keyboard in Update without having focus use it to ignore keys behind a modal.
+func isOutsideInputTrap(c Component) bool {
+	root := c
+	for parent := root.GetParent(); parent != nil; parent = root.GetParent() {
+		root = parent
+	}
+	trap := findInputTrap(root)
+	return trap != nil && !containsComponent(trap, c)
+}
+
+// focusTrapEntry remembers the focus to restore when an input trap is left
+type focusTrapEntry struct {
+	trap          Component
+	previousFocus FocusableComponent
+}
+
 type FocusManager struct {
 	focusableComponents []FocusableComponent
 	currentFocus        FocusableComponent
 	enabled             bool
+	traps               []focusTrapEntry
+	keyboardCaptured    bool
+	capturedFocus       FocusableComponent // Focus to restore when the keyboard is no longer captured
 }
 
 func NewFocusManager() *FocusManager {
@@ -60,6 +165,11 @@ func (fm *FocusManager) RefreshFocusableComponents(root Component) {
 
 	fm.focusableComponents = nil
 
+	// Only components inside an active input trap can receive focus
+	if trap := findInputTrap(root); trap != nil {
+		root = trap
+	}
+
 	// Find all focusable components
 	var findFocusables func(Component)
 	findFocusables = func(c Component) {
@@ -158,6 +268,96 @@ func (fm *FocusManager) HandleTab(shiftPressed bool) {
 	fm.SetFocus(fm.focusableComponents[nextIndex])
 }
 
+// updateInputTrap keeps focus inside the active input trap. Entering a trap focuses its first
+// focusable descendant, and leaving it restores the focus from before the trap was entered.
+func (fm *FocusManager) updateInputTrap(root Component) {
+	if !fm.enabled {
+		return
+	}
+
+	trap := findInputTrap(root)
+
+	var current Component
+	if len(fm.traps) > 0 {
+		current = fm.traps[len(fm.traps)-1].trap
+	}
+
+	if trap == current {
+		// Focus may not move outside of the trap
+		if trap != nil && fm.currentFocus != nil && !containsComponent(trap, fm.currentFocus) {
+			fm.SetFocus(nil)
+		}
+		return
+	}
+
+	// Returning to an enclosing trap, or leaving all traps, restores the earlier focus
+	for i := len(fm.traps) - 1; i >= 0; i-- {
+		var outer Component
+		if i > 0 {
+			outer = fm.traps[i-1].trap
+		}
+		if outer == trap {
+			fm.SetFocus(fm.traps[i].previousFocus)
+			fm.traps = fm.traps[:i]
+			return
+		}
+	}
+
+	// Entering a new trap
+	fm.traps = append(fm.traps, focusTrapEntry{trap: trap, previousFocus: fm.currentFocus})
+	fm.RefreshFocusableComponents(root)
+	for _, c := range fm.focusableComponents {
+		if c != trap {
+			fm.SetFocus(c)
+			return
+		}
+	}
+	fm.SetFocus(nil)
+}
+
+// isFocusReachable returns whether a component and all of its ancestors are shown and enabled
+func isFocusReachable(c Component) bool {
+	for ; c != nil; c = c.GetParent() {
+		if c.IsHidden() || c.IsDisabled() {
+			return false
+		}
+	}
+	return true
+}
+
+// dropUnreachableFocus blurs the focused component once it or one of its ancestors is hidden or disabled,
+// such as a component inside a collapsed section, so it stops handling keys it can no longer be seen to receive
+func (fm *FocusManager) dropUnreachableFocus() {
+	if fm.enabled && fm.currentFocus != nil && !isFocusReachable(fm.currentFocus) {
+		fm.SetFocus(nil)
+	}
+}
+
+// updateKeyboardCapture blurs the focused component while another component captures the keyboard,
+// and focuses it again once the capture ends, unless something else was focused in the meantime
+func (fm *FocusManager) updateKeyboardCapture(root Component) {
+	if !fm.enabled {
+		return
+	}
+
+	captured := findKeyboardCapture(root)
+	if captured == fm.keyboardCaptured {
+		return
+	}
+	fm.keyboardCaptured = captured
+
+	if captured {
+		fm.capturedFocus = fm.currentFocus
+		fm.SetFocus(nil)
+		return
+	}
+
+	if fm.currentFocus == nil && fm.capturedFocus != nil && !fm.capturedFocus.IsDisabled() && !fm.capturedFocus.IsHidden() {
+		fm.SetFocus(fm.capturedFocus)
+	}
+	fm.capturedFocus = nil
+}
+
 // Enable turns on focus management
 func (fm *FocusManager) Enable() {
 	fm.enabled = true
@@ -0,0 +1,191 @@
+package ebui
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var _ Component = &Image{}
+
+// ScaleMode determines how an image is sized to fit its component
+type ScaleMode int
+
+const (
+	// ScaleStretch stretches the image to fill the component, ignoring its aspect ratio
+	ScaleStretch ScaleMode = iota
+	// ScaleFit scales the image to fit inside the component, keeping its aspect ratio
+	ScaleFit
+	// ScaleFill scales the image to cover the component, keeping its aspect ratio and cropping the overflow
+	ScaleFill
+	// ScaleTile repeats the image at its original size
+	ScaleTile
+	// ScaleCenter draws the image at its original size in the center of the component
+	ScaleCenter
+)
+
+// Image is a component that displays an ebiten image
+type Image struct {
+	*BaseComponent
+	image      *ebiten.Image
+	sourceRect image.Rectangle
+	scaleMode  ScaleMode
+	tint       color.Color
+}
+
+// WithScaleMode sets how the image is sized to fit the component
+func WithScaleMode(mode ScaleMode) ComponentOpt {
+	return func(c Component) {
+		if i, ok := c.(*Image); ok {
+			i.scaleMode = mode
+		}
+	}
+}
+
+// WithTint multiplies the image colors by the given color
+func WithTint(tint color.Color) ComponentOpt {
+	return func(c Component) {
+		if i, ok := c.(*Image); ok {
+			i.tint = tint
+		}
+	}
+}
+
+// WithSourceRect draws only part of the image, such as a single sprite from a sprite sheet
+func WithSourceRect(rect image.Rectangle) ComponentOpt {
+	return func(c Component) {
+		if i, ok := c.(*Image); ok {
+			i.sourceRect = rect
+		}
+	}
+}
+
+// NewImage creates a new image component
+func NewImage(img *ebiten.Image, opts ...ComponentOpt) *Image {
+	i := &Image{
+		BaseComponent: NewBaseComponent(opts...),
+		image:         img,
+		scaleMode:     ScaleStretch,
+	}
+
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
+}
+
+func (i *Image) Draw(screen *ebiten.Image) {
+	if i.IsHidden() {
+		return
+	}
+
+	pos := i.GetAbsolutePosition()
+	size := i.GetSize()
+	if !size.IsDrawable() {
+		return
+	}
+
+	i.drawBackground(screen)
+
+	src := i.getSourceImage()
+	if src != nil {
+		i.drawImage(screen, src, pos, size)
+	}
+
+	i.drawDebug(screen)
+}
+
+func (i *Image) drawImage(screen *ebiten.Image, src *ebiten.Image, pos Position, size Size) {
+	srcWidth := float64(src.Bounds().Dx())
+	srcHeight := float64(src.Bounds().Dy())
+	if srcWidth <= 0 || srcHeight <= 0 {
+		return
+	}
+
+	// Modes that can draw outside the component are clipped to its bounds
+	clipped := screen.SubImage(image.Rect(
+		int(pos.X),
+		int(pos.Y),
+		int(pos.X+size.Width),
+		int(pos.Y+size.Height),
+	)).(*ebiten.Image)
+
+	switch i.scaleMode {
+	case ScaleStretch:
+		i.drawScaled(screen, src, pos.X, pos.Y, size.Width/srcWidth, size.Height/srcHeight)
+	case ScaleFit:
+		scale := math.Min(size.Width/srcWidth, size.Height/srcHeight)
+		x := pos.X + (size.Width-srcWidth*scale)/2
+		y := pos.Y + (size.Height-srcHeight*scale)/2
+		i.drawScaled(screen, src, x, y, scale, scale)
+	case ScaleFill:
+		scale := math.Max(size.Width/srcWidth, size.Height/srcHeight)
+		x := pos.X + (size.Width-srcWidth*scale)/2
+		y := pos.Y + (size.Height-srcHeight*scale)/2
+		i.drawScaled(clipped, src, x, y, scale, scale)
+	case ScaleTile:
+		for y := 0.0; y < size.Height; y += srcHeight {
+			for x := 0.0; x < size.Width; x += srcWidth {
+				i.drawScaled(clipped, src, pos.X+x, pos.Y+y, 1, 1)
+			}
+		}
+	case ScaleCenter:
+		x := pos.X + math.Floor((size.Width-srcWidth)/2)
+		y := pos.Y + math.Floor((size.Height-srcHeight)/2)
+		i.drawScaled(clipped, src, x, y, 1, 1)
+	}
+}
+
+func (i *Image) drawScaled(screen *ebiten.Image, src *ebiten.Image, x, y, scaleX, scaleY float64) {
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scaleX, scaleY)
+	op.GeoM.Translate(x, y)
+	if i.tint != nil {
+		op.ColorScale.ScaleWithColor(i.tint)
+	}
+	screen.DrawImage(src, op)
+}
+
+// getSourceImage returns the part of the image selected by the source rectangle
+func (i *Image) getSourceImage() *ebiten.Image {
+	if i.image == nil {
+		return nil
+	}
+	if i.sourceRect.Empty() {
+		return i.image
+	}
+	return i.image.SubImage(i.sourceRect).(*ebiten.Image)
+}
+
+// SetImage sets the image to display
+func (i *Image) SetImage(img *ebiten.Image) {
+	i.image = img
+}
+
+// GetImage returns the displayed image
+func (i *Image) GetImage() *ebiten.Image {
+	return i.image
+}
+
+// SetSourceRect sets the part of the image to display. An empty rectangle displays the whole image.
+func (i *Image) SetSourceRect(rect image.Rectangle) {
+	i.sourceRect = rect
+}
+
+// GetSourceRect returns the part of the image being displayed
+func (i *Image) GetSourceRect() image.Rectangle {
+	return i.sourceRect
+}
+
+// SetScaleMode sets how the image is sized to fit the component
+func (i *Image) SetScaleMode(mode ScaleMode) {
+	i.scaleMode = mode
+}
+
+// SetTint sets the color the image is multiplied by. A nil tint draws the image unchanged.
+func (i *Image) SetTint(tint color.Color) {
+	i.tint = tint
+}
@@ -1,26 +1,74 @@
 package ebui
 
 import (
+	"math"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 type InputManager struct {
 	lastHoverTarget InteractiveComponent
 	dragSource      InteractiveComponent
 	isDragging      bool
+	isDragPending   bool
+	dragButton      ebiten.MouseButton
+	pressTarget     InteractiveComponent
+	pressPath       []InteractiveComponent
+	pressX          float64
+	pressY          float64
+	pressTime       time.Time
+	dragThreshold   float64
+	dragDelay       time.Duration
+	dragData        *DragData
+	dragGhost       *ebiten.Image
+	ownsDragGhost   bool
+	dragGhostX      float64
+	dragGhostY      float64
+	dropTarget      InteractiveComponent
+	dropTargetPath  []InteractiveComponent
+	dropEffect      DropEffect
 	lastMouseX      float64
 	lastMouseY      float64
 	lastUpdateTime  int64
 	buttonStates    map[ebiten.MouseButton]bool
 	focusManager    *FocusManager
+	pointerCapture  InteractiveComponent
+	cursor          ebiten.CursorShapeType
 	tabRepeatStart  time.Time
 	tabRepeatLast   time.Time
+	escapeHandled   bool // Whether this frame's Esc press has been used
+}
+
+// EscapeHandler is implemented by components that close on Esc, such as open menus and modal windows.
+// Each Esc press is used once: a drag in progress is cancelled first, otherwise the press
+// is offered to the visible handlers from the topmost drawn down, until one returns true.
+type EscapeHandler interface {
+	HandleEscape() bool
 }
 
 type InputManagerOpt func(im *InputManager)
 
+// DefaultDragThreshold is the distance in pixels the pointer must move while a button is held to start a drag
+const DefaultDragThreshold = 4
+
+// WithDragThreshold sets the distance in pixels the pointer must move while a button is held to start a drag.
+// A threshold of 0 starts drags as soon as a button is pressed.
+func WithDragThreshold(distance float64) InputManagerOpt {
+	return func(im *InputManager) {
+		im.dragThreshold = distance
+	}
+}
+
+// WithDragDelay makes a drag start once a button has been held for the given duration, even without movement.
+// By default only the drag threshold distance starts drags.
+func WithDragDelay(delay time.Duration) InputManagerOpt {
+	return func(im *InputManager) {
+		im.dragDelay = delay
+	}
+}
+
 // WithFocusManager sets the focus manager for the input manager
 func WithFocusManager(fm *FocusManager) InputManagerOpt {
 	return func(im *InputManager) {
@@ -33,6 +81,7 @@ func NewInputManager(opts ...InputManagerOpt) *InputManager {
 		lastUpdateTime: time.Now().UnixNano(),
 		buttonStates:   make(map[ebiten.MouseButton]bool),
 		focusManager:   NewFocusManager(),
+		dragThreshold:  DefaultDragThreshold,
 	}
 
 	for _, opt := range opts {
@@ -138,8 +187,13 @@ func (im *InputManager) dispatchEvent(event *Event) bool {
 // It handles mouse button events, mouse movement, wheel events, and drag events.
 // The root component is used as the starting point for event propagation.
 func (im *InputManager) Update(root Component) {
+	im.escapeHandled = false
+	im.focusManager.updateInputTrap(root)
+	im.focusManager.dropUnreachableFocus()
+	im.focusManager.updateKeyboardCapture(root)
 	im.handleMouseInput(root)
 	im.handleKeyboardInput(root)
+	im.handleEscape(root)
 }
 
 func (im *InputManager) handleMouseInput(root Component) {
@@ -152,6 +206,29 @@ func (im *InputManager) handleMouseInput(root Component) {
 
 	target, path := findInteractiveComponentAt(root, fx, fy)
 
+	// Components outside an active input trap, such as windows behind a modal, receive no pointer input
+	trap := findInputTrap(root)
+	if trap != nil && target != nil && !containsComponent(trap, target) {
+		target, path = nil, nil
+	}
+
+	// Drop targets are always found by hit testing, even while the pointer is captured
+	hitTarget, hitPath := target, path
+	hoverTarget := target
+
+	// A component with pointer capture receives all pointer events, and is hovered only while under the pointer
+	if im.pointerCapture != nil {
+		if capturePath, ok := im.getPointerCapturePath(root, trap); ok {
+			target, path = im.pointerCapture, capturePath
+			hoverTarget = nil
+			if target.Contains(fx, fy) {
+				hoverTarget = target
+			}
+		} else {
+			im.releasePointerCapture()
+		}
+	}
+
 	// Base event properties
 	baseEvent := Event{
 		MouseX:      fx,
@@ -161,9 +238,12 @@ func (im *InputManager) handleMouseInput(root Component) {
 		Timestamp:   currentTime,
 		Bubbles:     true,
 		Path:        path,
+
+		inputManager: im,
 	}
 
 	// Handle mouse buttons
+	anyPressed := false
 	for _, btn := range []ebiten.MouseButton{
 		ebiten.MouseButtonLeft,
 		ebiten.MouseButtonRight,
@@ -171,6 +251,7 @@ func (im *InputManager) handleMouseInput(root Component) {
 	} {
 		wasPressed := im.buttonStates[btn]
 		isPressed := ebiten.IsMouseButtonPressed(btn)
+		anyPressed = anyPressed || isPressed
 
 		if isPressed != wasPressed {
 			evt := baseEvent
@@ -180,14 +261,25 @@ func (im *InputManager) handleMouseInput(root Component) {
 			if isPressed {
 				evt.Type = MouseDown
 
-				// Handle focus change on left click only if focus management is enabled
-				if btn == ebiten.MouseButtonLeft && im.focusManager.IsEnabled() {
+				// Handle focus change on left click only if focus management is enabled.
+				// Clicks blocked by an input trap leave the focus inside the trap unchanged.
+				if btn == ebiten.MouseButtonLeft && im.focusManager.IsEnabled() && (target != nil || trap == nil) {
 					if focusable, ok := target.(FocusableComponent); ok {
 						im.focusManager.SetFocus(focusable)
 					} else {
 						im.focusManager.SetFocus(nil)
 					}
 				}
+
+				// Only one drag is tracked at a time, started by the first button pressed
+				if !im.isDragPending && !im.isDragging && target != nil {
+					im.isDragPending = true
+					im.dragButton = btn
+					im.pressTarget = target
+					im.pressPath = path
+					im.pressX, im.pressY = fx, fy
+					im.pressTime = time.Now()
+				}
 			} else {
 				evt.Type = MouseUp
 			}
@@ -197,36 +289,44 @@ func (im *InputManager) handleMouseInput(root Component) {
 		}
 	}
 
+	// Pointer capture ends once every button is released
+	if !anyPressed {
+		im.releasePointerCapture()
+	}
+
 	// Handle wheel
 	wheelX, wheelY := ebiten.Wheel()
 	if wheelX != 0 || wheelY != 0 {
 		wheelEvent := baseEvent
 		wheelEvent.Type = Wheel
 		wheelEvent.Target, wheelEvent.Path = findScrollableContainerAt(root, fx, fy)
+		if trap != nil && wheelEvent.Target != nil && !containsComponent(trap, wheelEvent.Target) {
+			wheelEvent.Target, wheelEvent.Path = nil, nil
+		}
 		wheelEvent.WheelDeltaX = float64(wheelX)
 		wheelEvent.WheelDeltaY = float64(wheelY)
 		im.dispatchEvent(&wheelEvent)
 	}
 
 	// Handle hover/pointer movement
-	if target != im.lastHoverTarget {
+	if hoverTarget != im.lastHoverTarget {
 		if im.lastHoverTarget != nil {
 			leaveEvent := baseEvent
 			leaveEvent.Type = MouseLeave
 			leaveEvent.Target = im.lastHoverTarget
-			leaveEvent.RelatedTarget = target
+			leaveEvent.RelatedTarget = hoverTarget
 			im.dispatchEvent(&leaveEvent)
 		}
 
-		if target != nil {
+		if hoverTarget != nil {
 			enterEvent := baseEvent
 			enterEvent.Type = MouseEnter
-			enterEvent.Target = target
+			enterEvent.Target = hoverTarget
 			enterEvent.RelatedTarget = im.lastHoverTarget
 			im.dispatchEvent(&enterEvent)
 		}
 
-		im.lastHoverTarget = target
+		im.lastHoverTarget = hoverTarget
 	}
 
 	// Handle pointer movement
@@ -238,73 +338,174 @@ func (im *InputManager) handleMouseInput(root Component) {
 	}
 
 	// Handle drag events
-	if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
-		if !im.isDragging && target != nil {
-			dragStartEvent := baseEvent
-			dragStartEvent.Type = DragStart
-			dragStartEvent.Target = target
-
-			if im.dispatchEvent(&dragStartEvent) {
-				im.isDragging = true
-				im.dragSource = target
-			}
+	if im.isDragPending || im.isDragging {
+		im.handleDrag(baseEvent, hitTarget, hitPath)
+	}
+
+	im.updateCursor(hitPath, fx, fy)
+
+	im.lastMouseX = fx
+	im.lastMouseY = fy
+	im.lastUpdateTime = currentTime
+}
+
+// handleDrag starts a drag once the pointer moves past the drag threshold with a button held,
+// then dispatches drag events until the button is released or the drag is cancelled
+func (im *InputManager) handleDrag(baseEvent Event, target InteractiveComponent, path []InteractiveComponent) {
+	baseEvent.MouseButton = im.dragButton
+	baseEvent.Path = path
+
+	if !ebiten.IsMouseButtonPressed(im.dragButton) {
+		if im.isDragging && im.dragData != nil {
+			im.endDrag(baseEvent)
 		} else if im.isDragging {
-			dragEvent := baseEvent
-			dragEvent.Type = Drag
-			dragEvent.Target = im.dragSource
-			im.dispatchEvent(&dragEvent)
+			dragEndEvent := baseEvent
+			dragEndEvent.Type = DragEnd
+			dragEndEvent.Target = im.dragSource
 
 			if target != nil && target != im.dragSource {
-				dragOverEvent := baseEvent
-				dragOverEvent.Type = DragOver
-				dragOverEvent.Target = target
-				dragOverEvent.RelatedTarget = im.dragSource
-				im.dispatchEvent(&dragOverEvent)
+				dropEvent := baseEvent
+				dropEvent.Type = Drop
+				dropEvent.Target = target
+				dropEvent.RelatedTarget = im.dragSource
+				im.dispatchEvent(&dropEvent)
+				// assign drop target to dragEnd event
+				dragEndEvent.RelatedTarget = target
 			}
+
+			// dispatch DragEnd event later after assigning drop target
+			im.dispatchEvent(&dragEndEvent)
+
+			im.isDragging = false
+			im.dragSource = nil
 		}
-	} else if im.isDragging {
-		dragEndEvent := baseEvent
-		dragEndEvent.Type = DragEnd
-		dragEndEvent.Target = im.dragSource
-
-		if target != nil && target != im.dragSource {
-			dropEvent := baseEvent
-			dropEvent.Type = Drop
-			dropEvent.Target = target
-			dropEvent.RelatedTarget = im.dragSource
-			im.dispatchEvent(&dropEvent)
-			// assign drop target to dragEnd event
-			dragEndEvent.RelatedTarget = target
+		im.isDragPending = false
+		im.pressTarget = nil
+		im.pressPath = nil
+		return
+	}
+
+	if im.isDragPending {
+		if !im.exceedsDragThreshold(baseEvent.MouseX, baseEvent.MouseY) {
+			return
 		}
 
-		// dispatch DragEnd event later after assigning drop target
-		im.dispatchEvent(&dragEndEvent)
+		// DragStart reports where the button was pressed, so drags are anchored to the press position
+		dragStartEvent := baseEvent
+		dragStartEvent.Type = DragStart
+		dragStartEvent.Target = im.pressTarget
+		dragStartEvent.Path = im.pressPath
+		dragStartEvent.MouseX, dragStartEvent.MouseY = im.pressX, im.pressY
+		dragStartEvent.MouseDeltaX, dragStartEvent.MouseDeltaY = 0, 0
+
+		im.isDragPending = false
+		if im.dispatchEvent(&dragStartEvent) {
+			im.isDragging = true
+			im.dragSource = im.pressTarget
+			im.startDrag(dragStartEvent.DragData, im.pressX, im.pressY)
+		}
+		im.pressTarget = nil
+		im.pressPath = nil
 
-		im.isDragging = false
-		im.dragSource = nil
+		// Catch up with the pointer, which has moved away from the press position
+		baseEvent.MouseDeltaX = baseEvent.MouseX - im.pressX
+		baseEvent.MouseDeltaY = baseEvent.MouseY - im.pressY
 	}
 
-	im.lastMouseX = fx
-	im.lastMouseY = fy
-	im.lastUpdateTime = currentTime
+	if !im.isDragging {
+		return
+	}
+
+	// Escape cancels the drag. No new drag starts until a button is pressed again.
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		im.cancelDrag(baseEvent)
+		im.escapeHandled = true
+		return
+	}
+
+	if baseEvent.MouseDeltaX != 0 || baseEvent.MouseDeltaY != 0 {
+		dragEvent := baseEvent
+		dragEvent.Type = Drag
+		dragEvent.Target = im.dragSource
+		dragEvent.DragData = im.dragData
+		im.dispatchEvent(&dragEvent)
+	}
+
+	if im.dragData != nil {
+		im.updateDropTarget(baseEvent)
+	} else if target != nil && target != im.dragSource {
+		dragOverEvent := baseEvent
+		dragOverEvent.Type = DragOver
+		dragOverEvent.Target = target
+		dragOverEvent.RelatedTarget = im.dragSource
+		im.dispatchEvent(&dragOverEvent)
+	}
 }
 
-func (im *InputManager) handleKeyboardInput(root Component) {
-	// Skip keyboard input handling if focus management is disabled
-	if !im.focusManager.IsEnabled() {
+// exceedsDragThreshold returns whether a pending drag has moved far enough, or been held long enough, to start
+func (im *InputManager) exceedsDragThreshold(x, y float64) bool {
+	if math.Hypot(x-im.pressX, y-im.pressY) >= im.dragThreshold {
+		return true
+	}
+	return im.dragDelay > 0 && time.Since(im.pressTime) >= im.dragDelay
+}
+
+// handleEscape offers an Esc press that did not cancel a drag to the topmost escape handler.
+// A press no handler uses clears the focus.
+func (im *InputManager) handleEscape(root Component) {
+	if im.escapeHandled || !inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
 		return
 	}
+	im.escapeHandled = true
+
+	// Handlers behind an active input trap are skipped, but the handler that owns the trap may still close it
+	trap := findInputTrap(root)
+	handlers := collectEscapeHandlers(root, nil)
+	for i := len(handlers) - 1; i >= 0; i-- {
+		if c, ok := handlers[i].(Component); ok && trap != nil &&
+			!containsComponent(trap, c) && !containsComponent(c, trap) {
+			continue
+		}
+		if handlers[i].HandleEscape() {
+			return
+		}
+	}
 
-	escPressed := ebiten.IsKeyPressed(ebiten.KeyEscape)
-	if escPressed {
+	if im.focusManager.IsEnabled() {
 		im.focusManager.SetFocus(nil)
 	}
+}
+
+// collectEscapeHandlers returns the visible escape handlers in the order they are drawn
+func collectEscapeHandlers(root Component, handlers []EscapeHandler) []EscapeHandler {
+	if root == nil || root.IsHidden() {
+		return handlers
+	}
+	if handler, ok := root.(EscapeHandler); ok {
+		handlers = append(handlers, handler)
+	}
+	if container, ok := root.(Container); ok {
+		for _, child := range container.GetChildren() {
+			handlers = collectEscapeHandlers(child, handlers)
+		}
+	}
+	return handlers
+}
+
+func (im *InputManager) handleKeyboardInput(root Component) {
+	// Skip keyboard input handling if focus management is disabled, or while another component reads the keyboard
+	if !im.focusManager.IsEnabled() || im.focusManager.keyboardCaptured {
+		return
+	}
 
 	// Handle Tab key for focus navigation
 	tabPressed := ebiten.IsKeyPressed(ebiten.KeyTab)
 	shiftPressed := ebiten.IsKeyPressed(ebiten.KeyShift)
+	ctrlPressed := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	altPressed := ebiten.IsKeyPressed(ebiten.KeyAlt)
 
-	if !tabPressed {
+	// Ctrl+Tab is left to components such as TabContainer, and Alt+Tab to window cycling
+	if !tabPressed || ctrlPressed || altPressed {
 		im.tabRepeatStart = time.Time{}
 		im.tabRepeatLast = time.Time{}
 		return
@@ -3,11 +3,13 @@ package ebui
 import (
 	"image/color"
 	"strings"
+	"unicode"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
 )
 
 var _ Component = &Label{}
@@ -41,7 +43,10 @@ func WithColor(color color.Color) ComponentOpt {
 
 func WithFont(font font.Face) ComponentOpt {
 	return func(c Component) {
-		if b, ok := c.(*Label); ok {
+		switch b := c.(type) {
+		case *Label:
+			b.font = font
+		case *ProgressBar:
 			b.font = font
 		}
 	}
@@ -109,28 +114,100 @@ func (b *Label) calculateWrappedLines() {
 		return
 	}
 
-	currentLine := words[0]
+	// Wrap the words separated by single spaces, dropping the trailing space of each line
+	text := []rune(strings.Join(words, " "))
+	for _, span := range wrapText(b.font, text, maxWidth, false) {
+		b.lines = append(b.lines, strings.TrimRight(string(text[span.start:span.end]), " "))
+	}
+}
 
-	for _, word := range words[1:] {
-		// Try adding the next word
-		testLine := currentLine + " " + word
-		bounds, _ := font.BoundString(b.font, testLine)
-		width := (bounds.Max.X - bounds.Min.X).Ceil()
+// textSpan is a range of rune indices [start, end) within a text
+type textSpan struct {
+	start, end int
+}
 
-		if float64(width) <= maxWidth {
-			// Word fits, add it to the current line
-			currentLine = testLine
-		} else {
-			// Word doesn't fit, start a new line
-			b.lines = append(b.lines, currentLine)
-			currentLine = word
+// wrapText greedily splits text into lines that fit within maxWidth, breaking at spaces
+// and newlines. Spaces at the end of a line stay on that line and newlines are excluded
+// from the returned spans, so the spans preserve the original rune indices. Words wider
+// than maxWidth are broken between characters if breakWords is set, otherwise they
+// overflow on a line of their own.
+func wrapText(face font.Face, text []rune, maxWidth float64, breakWords bool) []textSpan {
+	var spans []textSpan
+
+	paragraphStart := 0
+	for paragraphStart <= len(text) {
+		// Find the end of the paragraph
+		paragraphEnd := paragraphStart
+		for paragraphEnd < len(text) && text[paragraphEnd] != '\n' {
+			paragraphEnd++
 		}
-	}
 
-	// Add the last line
-	if currentLine != "" {
-		b.lines = append(b.lines, currentLine)
+		lineStart := paragraphStart
+		for {
+			lineEnd := lineStart
+			lastSpace := -1
+			// The line width is kept as a running sum of advances, so each rune is measured once
+			var width fixed.Int26_6
+			for lineEnd < paragraphEnd {
+				r := text[lineEnd]
+				if lineEnd > lineStart {
+					width += face.Kern(text[lineEnd-1], r)
+				}
+				advance, _ := face.GlyphAdvance(r)
+				width += advance
+
+				if unicode.IsSpace(r) {
+					lastSpace = lineEnd
+				} else if float64(width.Ceil()) > maxWidth {
+					break
+				}
+				lineEnd++
+			}
+
+			if lineEnd == paragraphEnd {
+				spans = append(spans, textSpan{start: lineStart, end: paragraphEnd})
+				break
+			}
+
+			// The line overflows, decide where to break it
+			breakAt := lineEnd
+			switch {
+			case lastSpace >= lineStart:
+				breakAt = lastSpace + 1
+			case breakWords:
+				breakAt = max(lineEnd, lineStart+1)
+			default:
+				// Let the word overflow and break after it
+				for breakAt < paragraphEnd && !unicode.IsSpace(text[breakAt]) {
+					breakAt++
+				}
+				for breakAt < paragraphEnd && unicode.IsSpace(text[breakAt]) {
+					breakAt++
+				}
+			}
+
+			spans = append(spans, textSpan{start: lineStart, end: breakAt})
+			if breakAt >= paragraphEnd {
+				break
+			}
+			lineStart = breakAt
+		}
+
+		paragraphStart = paragraphEnd + 1
 	}
+
+	return spans
+}
+
+// textBoundsWidth returns the width of the inked bounds of the given runes in pixels
+func textBoundsWidth(face font.Face, text []rune) float64 {
+	bounds, _ := font.BoundString(face, string(text))
+	return float64((bounds.Max.X - bounds.Min.X).Ceil())
+}
+
+// measureText returns the advance width of the given runes in pixels
+func measureText(face font.Face, text []rune) float64 {
+	return float64(font.MeasureString(face, string(text)).Ceil())
 }
 
 func (b *Label) GetText() string {
@@ -0,0 +1,121 @@
+package ebui
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// kernFace tightens the pair "AV" by 2 pixels, so widths only fit when kerning is counted
+type kernFace struct {
+	font.Face
+}
+
+func (f kernFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	if (r0 == 'A' && r1 == 'V') || (r0 == 'V' && r1 == 'A') {
+		return fixed.I(-2)
+	}
+	return 0
+}
+
+func TestWrapText(t *testing.T) {
+	// Every glyph of basicfont.Face7x13 advances 7 pixels
+	face := basicfont.Face7x13
+
+	tests := []struct {
+		name       string
+		face       font.Face
+		text       string
+		maxWidth   float64
+		breakWords bool
+		want       []textSpan
+	}{
+		{
+			name:     "empty",
+			text:     "",
+			maxWidth: 100,
+			want:     []textSpan{{0, 0}},
+		},
+		{
+			name:     "fits",
+			text:     "hello",
+			maxWidth: 100,
+			want:     []textSpan{{0, 5}},
+		},
+		{
+			name:     "breaks at space",
+			text:     "hello world",
+			maxWidth: 50,
+			want:     []textSpan{{0, 6}, {6, 11}},
+		},
+		{
+			name:     "trailing spaces stay on the line",
+			text:     "ab   cd",
+			maxWidth: 28,
+			want:     []textSpan{{0, 5}, {5, 7}},
+		},
+		{
+			name:     "newlines are excluded",
+			text:     "ab\ncd",
+			maxWidth: 100,
+			want:     []textSpan{{0, 2}, {3, 5}},
+		},
+		{
+			name:     "trailing newline starts an empty line",
+			text:     "ab\n",
+			maxWidth: 100,
+			want:     []textSpan{{0, 2}, {3, 3}},
+		},
+		{
+			name:       "long word broken between characters",
+			text:       "abcdefghij",
+			maxWidth:   30,
+			breakWords: true,
+			want:       []textSpan{{0, 4}, {4, 8}, {8, 10}},
+		},
+		{
+			name:     "long word overflows on its own line",
+			text:     "abcdefghij kl",
+			maxWidth: 30,
+			want:     []textSpan{{0, 11}, {11, 13}},
+		},
+		{
+			name:       "at least one character per line",
+			text:       "abc",
+			maxWidth:   5,
+			breakWords: true,
+			want:       []textSpan{{0, 1}, {1, 2}, {2, 3}},
+		},
+		{
+			name:       "without kerning",
+			text:       "AVAV",
+			maxWidth:   22,
+			breakWords: true,
+			want:       []textSpan{{0, 3}, {3, 4}},
+		},
+		{
+			name:       "running width includes kerning",
+			face:       kernFace{face},
+			text:       "AVAV",
+			maxWidth:   22,
+			breakWords: true,
+			want:       []textSpan{{0, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tt.face
+			if f == nil {
+				f = face
+			}
+			got := wrapText(f, []rune(tt.text), tt.maxWidth, tt.breakWords)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("wrapText(%q, %v) = %v, want %v", tt.text, tt.maxWidth, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,720 @@
+package ebui
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ InteractiveComponent = &Menu{}
+var _ InteractiveComponent = &MenuManager{}
+var _ EscapeHandler = &MenuManager{}
+var _ KeyboardCapture = &MenuManager{}
+
+// MenuColors represents the color scheme for menus and menu bars
+type MenuColors struct {
+	Background    color.Color
+	Border        color.Color
+	Text          color.Color
+	DisabledText  color.Color
+	Shortcut      color.Color
+	Highlight     color.Color
+	HighlightText color.Color
+	Separator     color.Color
+}
+
+// DefaultMenuColors returns a default color scheme for menus
+func DefaultMenuColors() MenuColors {
+	return MenuColors{
+		Background:    color.RGBA{245, 245, 245, 255},
+		Border:        color.RGBA{160, 160, 160, 255},
+		Text:          color.Black,
+		DisabledText:  color.RGBA{160, 160, 160, 255},
+		Shortcut:      color.RGBA{110, 110, 110, 255},
+		Highlight:     color.RGBA{100, 149, 237, 255}, // Cornflower blue
+		HighlightText: color.White,
+		Separator:     color.RGBA{210, 210, 210, 255},
+	}
+}
+
+// MenuItem is an entry in a Menu
+type MenuItem struct {
+	label     string
+	shortcut  string
+	action    func()
+	submenu   *Menu
+	separator bool
+	checkable bool
+	checked   bool
+	disabled  bool
+}
+
+// MenuItemOpt is a function that configures a MenuItem
+type MenuItemOpt func(i *MenuItem)
+
+// WithShortcut shows a keyboard shortcut hint such as "Ctrl+S" next to the item
+func WithShortcut(shortcut string) MenuItemOpt {
+	return func(i *MenuItem) {
+		i.shortcut = shortcut
+	}
+}
+
+// WithMenuAction sets the function called when the item is activated
+func WithMenuAction(action func()) MenuItemOpt {
+	return func(i *MenuItem) {
+		i.action = action
+	}
+}
+
+// WithCheckable makes the item toggle a check mark when activated
+func WithCheckable(checked bool) MenuItemOpt {
+	return func(i *MenuItem) {
+		i.checkable = true
+		i.checked = checked
+	}
+}
+
+// WithSubmenu makes the item open a nested menu
+func WithSubmenu(submenu *Menu) MenuItemOpt {
+	return func(i *MenuItem) {
+		i.submenu = submenu
+	}
+}
+
+// WithMenuItemDisabled shows the item grayed out and prevents it from being activated
+func WithMenuItemDisabled() MenuItemOpt {
+	return func(i *MenuItem) {
+		i.disabled = true
+	}
+}
+
+// NewMenuItem creates a new menu item
+func NewMenuItem(label string, opts ...MenuItemOpt) *MenuItem {
+	i := &MenuItem{
+		label: label,
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// NewMenuSeparator creates a separator line between groups of menu items
+func NewMenuSeparator() *MenuItem {
+	return &MenuItem{separator: true}
+}
+
+func (i *MenuItem) GetLabel() string {
+	return i.label
+}
+
+func (i *MenuItem) SetLabel(label string) {
+	i.label = label
+}
+
+func (i *MenuItem) GetShortcut() string {
+	return i.shortcut
+}
+
+func (i *MenuItem) SetShortcut(shortcut string) {
+	i.shortcut = shortcut
+}
+
+func (i *MenuItem) SetAction(action func()) {
+	i.action = action
+}
+
+func (i *MenuItem) GetSubmenu() *Menu {
+	return i.submenu
+}
+
+func (i *MenuItem) IsSeparator() bool {
+	return i.separator
+}
+
+func (i *MenuItem) IsCheckable() bool {
+	return i.checkable
+}
+
+func (i *MenuItem) IsChecked() bool {
+	return i.checked
+}
+
+func (i *MenuItem) SetChecked(checked bool) {
+	i.checked = checked
+}
+
+func (i *MenuItem) IsDisabled() bool {
+	return i.disabled
+}
+
+func (i *MenuItem) SetDisabled(disabled bool) {
+	i.disabled = disabled
+}
+
+// isSelectable reports whether the item can be highlighted
+func (i *MenuItem) isSelectable() bool {
+	return !i.separator && !i.disabled
+}
+
+// Menu is a popup list of menu items. Menus are shown by a MenuManager,
+// either from a MenuBar or as a context menu.
+type Menu struct {
+	*BaseInteractive
+	*BaseComponent
+	items           []*MenuItem
+	colors          MenuColors
+	font            font.Face
+	itemHeight      float64
+	separatorHeight float64
+	highlighted     int
+	manager         *MenuManager
+	parent          *Menu
+	openSubmenu     *Menu
+}
+
+// WithMenuColors sets the colors for a menu or menu bar
+func WithMenuColors(colors MenuColors) ComponentOpt {
+	return func(c Component) {
+		switch m := c.(type) {
+		case *Menu:
+			m.colors = colors
+		case *MenuBar:
+			m.colors = colors
+		}
+	}
+}
+
+// WithMenuItemHeight sets the height of each item in the menu
+func WithMenuItemHeight(height float64) ComponentOpt {
+	return func(c Component) {
+		if m, ok := c.(*Menu); ok {
+			m.itemHeight = height
+		}
+	}
+}
+
+// NewMenu creates a new menu with the given items
+func NewMenu(items []*MenuItem, opts ...ComponentOpt) *Menu {
+	m := &Menu{
+		BaseInteractive: NewBaseInteractive(),
+		BaseComponent:   NewBaseComponent(opts...),
+		items:           items,
+		colors:          DefaultMenuColors(),
+		font:            basicfont.Face7x13,
+		itemHeight:      22,
+		separatorHeight: 9,
+		highlighted:     -1,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.updateSize()
+	m.registerEventListeners()
+	return m
+}
+
+const (
+	menuPadding      = 4.0
+	menuCheckWidth   = 20.0
+	menuArrowWidth   = 16.0
+	menuShortcutGap  = 24.0
+	menuMinimumWidth = 120.0
+)
+
+func (m *Menu) registerEventListeners() {
+	m.AddEventListener(MouseMove, func(e *Event) {
+		if e.Target != m {
+			return
+		}
+		index := m.getItemAt(e.MouseY)
+		if index == -1 || !m.items[index].isSelectable() {
+			return
+		}
+		m.setHighlighted(index)
+
+		// Hovering an item with a submenu opens it
+		if m.items[index].submenu != nil && m.manager != nil {
+			m.manager.openSubmenu(m, index, false)
+		}
+	})
+
+	m.AddEventListener(MouseUp, func(e *Event) {
+		if e.Target != m || e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		index := m.getItemAt(e.MouseY)
+		if index != -1 {
+			m.activate(index, false)
+		}
+	})
+}
+
+// setHighlighted highlights an item, closing the submenu of any other item
+func (m *Menu) setHighlighted(index int) {
+	if m.highlighted == index {
+		return
+	}
+	m.highlighted = index
+	if m.openSubmenu != nil && m.manager != nil {
+		m.manager.closeMenu(m.openSubmenu)
+	}
+}
+
+// activate runs the item at the index, or opens its submenu
+func (m *Menu) activate(index int, fromKeyboard bool) {
+	item := m.items[index]
+	if !item.isSelectable() {
+		return
+	}
+
+	if item.submenu != nil {
+		if m.manager != nil {
+			m.manager.openSubmenu(m, index, fromKeyboard)
+		}
+		return
+	}
+
+	if item.checkable {
+		item.checked = !item.checked
+	}
+
+	// Close the menus first so the action can open other popups
+	if m.manager != nil {
+		m.manager.CloseAll()
+	}
+	if item.action != nil {
+		item.action()
+	}
+}
+
+// moveHighlight moves the highlight to the next selectable item in the given direction, wrapping around
+func (m *Menu) moveHighlight(delta int) {
+	if len(m.items) == 0 {
+		return
+	}
+
+	index := m.highlighted
+	if index == -1 && delta < 0 {
+		index = len(m.items)
+	}
+	for range m.items {
+		index = (index + delta + len(m.items)) % len(m.items)
+		if m.items[index].isSelectable() {
+			m.setHighlighted(index)
+			return
+		}
+	}
+}
+
+// highlightFirst highlights the first selectable item
+func (m *Menu) highlightFirst() {
+	m.highlighted = -1
+	m.moveHighlight(1)
+}
+
+// updateSize sizes the menu to fit its items
+func (m *Menu) updateSize() {
+	labelWidth, shortcutWidth := 0.0, 0.0
+	height := menuPadding * 2
+	for _, item := range m.items {
+		height += m.getItemHeight(item)
+		if item.separator {
+			continue
+		}
+		labelWidth = math.Max(labelWidth, float64(font.MeasureString(m.font, item.label).Ceil()))
+		shortcutWidth = math.Max(shortcutWidth, float64(font.MeasureString(m.font, item.shortcut).Ceil()))
+	}
+
+	width := menuCheckWidth + labelWidth + menuArrowWidth + menuPadding*2
+	if shortcutWidth > 0 {
+		width += menuShortcutGap + shortcutWidth
+	}
+	m.SetSize(Size{Width: math.Max(width, menuMinimumWidth), Height: height})
+}
+
+func (m *Menu) getItemHeight(item *MenuItem) float64 {
+	if item.separator {
+		return m.separatorHeight
+	}
+	return m.itemHeight
+}
+
+// getItemY returns the absolute y position of the item at the index
+func (m *Menu) getItemY(index int) float64 {
+	y := m.GetAbsolutePosition().Y + menuPadding
+	for i := 0; i < index; i++ {
+		y += m.getItemHeight(m.items[i])
+	}
+	return y
+}
+
+// getItemAt returns the index of the item at an absolute y position, or -1
+func (m *Menu) getItemAt(y float64) int {
+	itemY := m.GetAbsolutePosition().Y + menuPadding
+	for i, item := range m.items {
+		height := m.getItemHeight(item)
+		if y >= itemY && y < itemY+height {
+			return i
+		}
+		itemY += height
+	}
+	return -1
+}
+
+func (m *Menu) Draw(screen *ebiten.Image) {
+	if m.IsHidden() {
+		return
+	}
+
+	pos := m.GetAbsolutePosition()
+	size := m.GetSize()
+
+	border := GetCache().ImageWithColor(int(size.Width), int(size.Height), m.colors.Border)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(border, op)
+
+	bg := GetCache().ImageWithColor(int(size.Width-2), int(size.Height-2), m.colors.Background)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X+1, pos.Y+1)
+	screen.DrawImage(bg, op)
+
+	for i, item := range m.items {
+		m.drawItem(screen, i, item)
+	}
+
+	m.drawDebug(screen)
+}
+
+func (m *Menu) drawItem(screen *ebiten.Image, index int, item *MenuItem) {
+	pos := m.GetAbsolutePosition()
+	size := m.GetSize()
+	y := m.getItemY(index)
+	height := m.getItemHeight(item)
+
+	if item.separator {
+		line := GetCache().ImageWithColor(int(size.Width-menuPadding*2), 1, m.colors.Separator)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X+menuPadding, y+math.Floor(height/2))
+		screen.DrawImage(line, op)
+		return
+	}
+
+	textColor := m.colors.Text
+	shortcutColor := m.colors.Shortcut
+	if item.disabled {
+		textColor = m.colors.DisabledText
+		shortcutColor = m.colors.DisabledText
+	} else if index == m.highlighted {
+		highlight := GetCache().ImageWithColor(int(size.Width-menuPadding*2), int(height), m.colors.Highlight)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X+menuPadding, y)
+		screen.DrawImage(highlight, op)
+		textColor = m.colors.HighlightText
+		shortcutColor = m.colors.HighlightText
+	}
+
+	if item.checkable && item.checked {
+		check := GetCache().ImageWithColor(6, 6, textColor)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X+menuPadding+(menuCheckWidth-6)/2, y+(height-6)/2)
+		screen.DrawImage(check, op)
+	}
+
+	metrics := m.font.Metrics()
+	baseline := int(y + (height-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil()))
+	text.Draw(screen, item.label, m.font, int(pos.X+menuPadding+menuCheckWidth), baseline, textColor)
+
+	if item.shortcut != "" {
+		width := float64(font.MeasureString(m.font, item.shortcut).Ceil())
+		x := pos.X + size.Width - menuPadding - menuArrowWidth - width
+		text.Draw(screen, item.shortcut, m.font, int(x), baseline, shortcutColor)
+	}
+
+	if item.submenu != nil {
+		drawArrow(screen, pos.X+size.Width-menuPadding-menuArrowWidth/2, y+height/2, 8, DirectionRight, textColor)
+	}
+}
+
+// AddItem adds an item to the end of the menu
+func (m *Menu) AddItem(item *MenuItem) *MenuItem {
+	m.items = append(m.items, item)
+	m.updateSize()
+	return item
+}
+
+// AddSeparator adds a separator to the end of the menu
+func (m *Menu) AddSeparator() {
+	m.AddItem(NewMenuSeparator())
+}
+
+// RemoveItem removes an item from the menu
+func (m *Menu) RemoveItem(item *MenuItem) {
+	for i, it := range m.items {
+		if it == item {
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			m.highlighted = -1
+			m.updateSize()
+			return
+		}
+	}
+}
+
+// GetItems returns the items in the menu
+func (m *Menu) GetItems() []*MenuItem {
+	return m.items
+}
+
+// SetFont sets the font used for the menu items
+func (m *Menu) SetFont(font font.Face) {
+	m.font = font
+	m.updateSize()
+}
+
+// SetColors sets the color scheme for the menu
+func (m *Menu) SetColors(colors MenuColors) {
+	m.colors = colors
+}
+
+// IsOpen returns whether the menu is currently shown
+func (m *Menu) IsOpen() bool {
+	return m.manager != nil
+}
+
+// MenuManager is an overlay that shows menus above the rest of the UI.
+// Add it to the root container after all other components so menus are drawn on top.
+// While a menu is open, clicks outside of it close the menu instead of reaching the UI below.
+type MenuManager struct {
+	*BaseInteractive
+	*ZIndexedContainer
+	openMenus    []*Menu
+	bar          *MenuBar // Menu bar the open menus belong to, if any
+	contextEvent *Event   // Last event that opened a context menu
+	skipKeys     bool     // Set when a menu was opened by a key press this frame
+}
+
+// NewMenuManager creates a new menu manager
+func NewMenuManager(opts ...ComponentOpt) *MenuManager {
+	mm := &MenuManager{
+		BaseInteractive:   NewBaseInteractive(),
+		ZIndexedContainer: NewZIndexedContainer(opts...),
+	}
+	mm.registerEventListeners()
+	return mm
+}
+
+func (mm *MenuManager) registerEventListeners() {
+	mm.AddEventListener(MouseDown, func(e *Event) {
+		if e.Target != mm {
+			return
+		}
+
+		// Clicking another title of the menu bar switches menus, clicking the open one closes it
+		if mm.bar != nil {
+			if index := mm.bar.getTitleAt(e.MouseX, e.MouseY); index != -1 {
+				if index == mm.bar.openIndex {
+					mm.CloseAll()
+				} else {
+					mm.bar.openMenu(index, false)
+				}
+				return
+			}
+		}
+
+		mm.CloseAll()
+	})
+
+	mm.AddEventListener(MouseMove, func(e *Event) {
+		if e.Target != mm || mm.bar == nil {
+			return
+		}
+
+		// Moving across the menu bar while a menu is open switches menus
+		if index := mm.bar.getTitleAt(e.MouseX, e.MouseY); index != -1 && index != mm.bar.openIndex {
+			mm.bar.openMenu(index, false)
+		}
+	})
+}
+
+// Contains only covers the screen while a menu is open, so outside clicks can close it
+func (mm *MenuManager) Contains(x, y float64) bool {
+	return len(mm.openMenus) > 0 && mm.ZIndexedContainer.Contains(x, y)
+}
+
+func (mm *MenuManager) Update() error {
+	// Keys that opened a menu this frame should not also navigate it
+	if mm.skipKeys {
+		mm.skipKeys = false
+	} else if len(mm.openMenus) > 0 && !isOutsideInputTrap(mm) {
+		// Menus left open behind a modal are not navigated with the keyboard
+		mm.handleKeyboardInput()
+	}
+	return mm.ZIndexedContainer.Update()
+}
+
+// CapturesKeyboard takes the keyboard from the focused component while a menu is open
+func (mm *MenuManager) CapturesKeyboard() bool {
+	return len(mm.openMenus) > 0
+}
+
+// HandleEscape closes the topmost open menu. Closing a submenu leaves its parent menu open.
+func (mm *MenuManager) HandleEscape() bool {
+	if len(mm.openMenus) == 0 {
+		return false
+	}
+	if menu := mm.openMenus[len(mm.openMenus)-1]; menu.parent != nil {
+		mm.closeMenu(menu)
+	} else {
+		mm.CloseAll()
+	}
+	return true
+}
+
+func (mm *MenuManager) handleKeyboardInput() {
+	menu := mm.openMenus[len(mm.openMenus)-1]
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		menu.moveHighlight(1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		menu.moveHighlight(-1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyHome):
+		menu.highlightFirst()
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnd):
+		menu.highlighted = 0
+		menu.moveHighlight(-1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		if menu.highlighted != -1 && menu.items[menu.highlighted].submenu != nil {
+			mm.openSubmenu(menu, menu.highlighted, true)
+		} else if mm.bar != nil {
+			mm.bar.openAdjacentMenu(1)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		if menu.parent != nil {
+			mm.closeMenu(menu)
+		} else if mm.bar != nil {
+			mm.bar.openAdjacentMenu(-1)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter), inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		if menu.highlighted != -1 {
+			menu.activate(menu.highlighted, true)
+		}
+	}
+}
+
+// ShowMenu opens a menu at the given position, closing any open menus.
+// The menu is moved to stay within the manager's bounds.
+func (mm *MenuManager) ShowMenu(menu *Menu, x, y float64) {
+	mm.CloseAll()
+	mm.open(menu, nil, x, y)
+}
+
+// RegisterContextMenu shows the menu at the cursor when the component is right-clicked
+func (mm *MenuManager) RegisterContextMenu(component InteractiveComponent, menu *Menu) HandlerID {
+	return component.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonRight || e.Phase == PhaseCapture {
+			return
+		}
+		// The innermost component with a context menu handles the click first, ancestors ignore it
+		if mm.contextEvent == e {
+			return
+		}
+		mm.contextEvent = e
+		mm.ShowMenu(menu, e.MouseX, e.MouseY)
+	})
+}
+
+// open shows a menu at a position, keeping it within bounds
+func (mm *MenuManager) open(menu *Menu, parent *Menu, x, y float64) {
+	bounds := mm.GetSize()
+	size := menu.GetSize()
+	x = clamp(x, 0, math.Max(0, bounds.Width-size.Width))
+	y = clamp(y, 0, math.Max(0, bounds.Height-size.Height))
+
+	menu.manager = mm
+	menu.parent = parent
+	menu.openSubmenu = nil
+	menu.highlighted = -1
+	menu.SetPosition(Position{X: x, Y: y, ZIndex: len(mm.openMenus)})
+	if parent != nil {
+		parent.openSubmenu = menu
+	}
+
+	mm.openMenus = append(mm.openMenus, menu)
+	mm.AddChild(menu)
+}
+
+// openSubmenu opens the submenu of an item beside its parent menu
+func (mm *MenuManager) openSubmenu(parent *Menu, index int, fromKeyboard bool) {
+	submenu := parent.items[index].submenu
+	if submenu == nil {
+		return
+	}
+	if parent.openSubmenu == submenu {
+		if fromKeyboard {
+			submenu.highlightFirst()
+		}
+		return
+	}
+	if parent.openSubmenu != nil {
+		mm.closeMenu(parent.openSubmenu)
+	}
+	parent.highlighted = index
+
+	// Open to the right of the parent, or to the left if there is no room
+	parentPos := parent.GetAbsolutePosition()
+	x := parentPos.X + parent.GetSize().Width - 2
+	if x+submenu.GetSize().Width > mm.GetSize().Width {
+		x = parentPos.X - submenu.GetSize().Width + 2
+	}
+	y := parent.getItemY(index) - menuPadding
+
+	mm.open(submenu, parent, x, y)
+	if fromKeyboard {
+		submenu.highlightFirst()
+	}
+}
+
+// closeMenu closes a menu along with any submenus opened from it
+func (mm *MenuManager) closeMenu(menu *Menu) {
+	for i, open := range mm.openMenus {
+		if open != menu {
+			continue
+		}
+		for _, closing := range mm.openMenus[i:] {
+			mm.RemoveChild(closing)
+			closing.manager = nil
+			closing.openSubmenu = nil
+		}
+		mm.openMenus = mm.openMenus[:i]
+		if menu.parent != nil {
+			menu.parent.openSubmenu = nil
+		}
+		break
+	}
+
+	if len(mm.openMenus) == 0 && mm.bar != nil {
+		mm.bar.menuClosed()
+		mm.bar = nil
+	}
+}
+
+// CloseAll closes every open menu
+func (mm *MenuManager) CloseAll() {
+	if len(mm.openMenus) > 0 {
+		mm.closeMenu(mm.openMenus[0])
+	}
+}
+
+// HasOpenMenu returns whether any menu is currently open
+func (mm *MenuManager) HasOpenMenu() bool {
+	return len(mm.openMenus) > 0
+}
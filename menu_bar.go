@@ -0,0 +1,230 @@
+package ebui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ FocusableComponent = &MenuBar{}
+
+type menuBarEntry struct {
+	title string
+	menu  *Menu
+}
+
+// MenuBar is a horizontal bar of menu titles, typically placed along the top of the screen.
+// Its menus are shown by a MenuManager so they are drawn above the rest of the UI.
+type MenuBar struct {
+	*BaseFocusable
+	*BaseComponent
+	manager      *MenuManager
+	entries      []menuBarEntry
+	colors       MenuColors
+	font         font.Face
+	titlePadding float64
+	openIndex    int // Index of the entry whose menu is open, or -1
+	highlighted  int // Entry selected with the keyboard while focused
+	isFocused    bool
+}
+
+// NewMenuBar creates a new menu bar that opens its menus with the given menu manager
+func NewMenuBar(manager *MenuManager, opts ...ComponentOpt) *MenuBar {
+	mb := &MenuBar{
+		BaseFocusable: NewBaseFocusable(),
+		BaseComponent: NewBaseComponent(opts...),
+		manager:       manager,
+		colors:        DefaultMenuColors(),
+		font:          basicfont.Face7x13,
+		titlePadding:  10,
+		openIndex:     -1,
+	}
+
+	for _, opt := range opts {
+		opt(mb)
+	}
+
+	mb.registerEventListeners()
+	return mb
+}
+
+func (mb *MenuBar) registerEventListeners() {
+	mb.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		if index := mb.getTitleAt(e.MouseX, e.MouseY); index != -1 {
+			mb.highlighted = index
+			mb.openMenu(index, false)
+		}
+	})
+
+	mb.AddEventListener(Focus, func(e *Event) {
+		mb.isFocused = true
+	})
+
+	mb.AddEventListener(Blur, func(e *Event) {
+		mb.isFocused = false
+	})
+}
+
+func (mb *MenuBar) Update() error {
+	// While a menu is open the menu manager handles the keyboard
+	if mb.isFocused && mb.openIndex == -1 && len(mb.entries) > 0 {
+		mb.handleKeyboardInput()
+	}
+	return mb.BaseComponent.Update()
+}
+
+func (mb *MenuBar) handleKeyboardInput() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		mb.highlighted = (mb.highlighted - 1 + len(mb.entries)) % len(mb.entries)
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		mb.highlighted = (mb.highlighted + 1) % len(mb.entries)
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown),
+		inpututil.IsKeyJustPressed(ebiten.KeyEnter),
+		inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		mb.openMenu(mb.highlighted, true)
+	}
+}
+
+// openMenu shows the menu of the entry at the index below its title
+func (mb *MenuBar) openMenu(index int, fromKeyboard bool) {
+	if mb.manager == nil || index < 0 || index >= len(mb.entries) {
+		return
+	}
+
+	x, y, _ := mb.getTitleBounds(index)
+	mb.manager.ShowMenu(mb.entries[index].menu, x, y+mb.GetSize().Height)
+	mb.manager.bar = mb
+	mb.openIndex = index
+	mb.highlighted = index
+
+	if fromKeyboard {
+		mb.entries[index].menu.highlightFirst()
+		mb.manager.skipKeys = true
+	}
+}
+
+// openAdjacentMenu moves the open menu left or right along the bar, keeping keyboard selection
+func (mb *MenuBar) openAdjacentMenu(delta int) {
+	if mb.openIndex == -1 || len(mb.entries) == 0 {
+		return
+	}
+	mb.openMenu((mb.openIndex+delta+len(mb.entries))%len(mb.entries), true)
+}
+
+// menuClosed is called by the menu manager when the bar's menu is closed
+func (mb *MenuBar) menuClosed() {
+	mb.openIndex = -1
+}
+
+// getTitleBounds returns the absolute x, y and width of the title at the index
+func (mb *MenuBar) getTitleBounds(index int) (float64, float64, float64) {
+	pos := mb.GetAbsolutePosition()
+	x := pos.X
+	for i, entry := range mb.entries {
+		width := float64(font.MeasureString(mb.font, entry.title).Ceil()) + mb.titlePadding*2
+		if i == index {
+			return x, pos.Y, width
+		}
+		x += width
+	}
+	return x, pos.Y, 0
+}
+
+// getTitleAt returns the index of the title at an absolute position, or -1
+func (mb *MenuBar) getTitleAt(x, y float64) int {
+	pos := mb.GetAbsolutePosition()
+	if y < pos.Y || y >= pos.Y+mb.GetSize().Height {
+		return -1
+	}
+	for i := range mb.entries {
+		titleX, _, width := mb.getTitleBounds(i)
+		if x >= titleX && x < titleX+width {
+			return i
+		}
+	}
+	return -1
+}
+
+func (mb *MenuBar) Draw(screen *ebiten.Image) {
+	if mb.IsHidden() {
+		return
+	}
+
+	pos := mb.GetAbsolutePosition()
+	size := mb.GetSize()
+	if !size.IsDrawable() {
+		return
+	}
+
+	bg := GetCache().ImageWithColor(int(size.Width), int(size.Height), mb.colors.Background)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(bg, op)
+
+	line := GetCache().ImageWithColor(int(size.Width), 1, mb.colors.Border)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y+size.Height-1)
+	screen.DrawImage(line, op)
+
+	metrics := mb.font.Metrics()
+	baseline := int(pos.Y + (size.Height-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil()))
+	for i, entry := range mb.entries {
+		x, y, width := mb.getTitleBounds(i)
+		textColor := mb.colors.Text
+		if i == mb.openIndex || (mb.isFocused && i == mb.highlighted) {
+			highlight := GetCache().ImageWithColor(int(width), int(size.Height-1), mb.colors.Highlight)
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(x, y)
+			screen.DrawImage(highlight, op)
+			textColor = mb.colors.HighlightText
+		}
+		text.Draw(screen, entry.title, mb.font, int(x+mb.titlePadding), baseline, textColor)
+	}
+
+	mb.drawDebug(screen)
+}
+
+// AddMenu adds a menu with the given title to the end of the bar
+func (mb *MenuBar) AddMenu(title string, menu *Menu) *Menu {
+	mb.entries = append(mb.entries, menuBarEntry{title: title, menu: menu})
+	return menu
+}
+
+// RemoveMenu removes a menu from the bar
+func (mb *MenuBar) RemoveMenu(menu *Menu) {
+	for i, entry := range mb.entries {
+		if entry.menu == menu {
+			if mb.openIndex != -1 && mb.manager != nil {
+				mb.manager.CloseAll()
+			}
+			mb.entries = append(mb.entries[:i], mb.entries[i+1:]...)
+			mb.highlighted = 0
+			return
+		}
+	}
+}
+
+// GetMenus returns the menus in the bar in order
+func (mb *MenuBar) GetMenus() []*Menu {
+	menus := make([]*Menu, len(mb.entries))
+	for i, entry := range mb.entries {
+		menus[i] = entry.menu
+	}
+	return menus
+}
+
+// SetFont sets the font used for the menu titles
+func (mb *MenuBar) SetFont(font font.Face) {
+	mb.font = font
+}
+
+// SetColors sets the color scheme for the menu bar
+func (mb *MenuBar) SetColors(colors MenuColors) {
+	mb.colors = colors
+}
@@ -0,0 +1,85 @@
+package ebui
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// NineSlice is a texture split into a 3x3 grid by four insets. When drawn at any size
+// the corners keep their size, the edges stretch along one axis and the center stretches
+// along both, which allows a single texture to skin panels of any size.
+type NineSlice struct {
+	image  *ebiten.Image
+	left   int
+	top    int
+	right  int
+	bottom int
+}
+
+// NewNineSlice creates a nine-slice from an image and the size of its left, top, right and bottom borders
+func NewNineSlice(img *ebiten.Image, left, top, right, bottom int) *NineSlice {
+	return &NineSlice{
+		image:  img,
+		left:   left,
+		top:    top,
+		right:  right,
+		bottom: bottom,
+	}
+}
+
+// GetImage returns the source image of the nine-slice
+func (n *NineSlice) GetImage() *ebiten.Image {
+	return n.image
+}
+
+// GetInsets returns the left, top, right and bottom border sizes
+func (n *NineSlice) GetInsets() (left, top, right, bottom int) {
+	return n.left, n.top, n.right, n.bottom
+}
+
+// Draw draws the nine-slice stretched over the given rectangle
+func (n *NineSlice) Draw(screen *ebiten.Image, x, y, width, height float64) {
+	if n.image == nil || width <= 0 || height <= 0 {
+		return
+	}
+
+	b := n.image.Bounds()
+	left, right := float64(n.left), float64(n.right)
+	top, bottom := float64(n.top), float64(n.bottom)
+
+	// Shrink the borders proportionally when the target is smaller than they are
+	if left+right > width {
+		scale := width / (left + right)
+		left *= scale
+		right *= scale
+	}
+	if top+bottom > height {
+		scale := height / (top + bottom)
+		top *= scale
+		bottom *= scale
+	}
+
+	srcX := [4]int{b.Min.X, b.Min.X + n.left, b.Max.X - n.right, b.Max.X}
+	srcY := [4]int{b.Min.Y, b.Min.Y + n.top, b.Max.Y - n.bottom, b.Max.Y}
+	dstX := [4]float64{x, x + left, x + width - right, x + width}
+	dstY := [4]float64{y, y + top, y + height - bottom, y + height}
+
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			srcWidth := srcX[col+1] - srcX[col]
+			srcHeight := srcY[row+1] - srcY[row]
+			dstWidth := dstX[col+1] - dstX[col]
+			dstHeight := dstY[row+1] - dstY[row]
+			if srcWidth <= 0 || srcHeight <= 0 || dstWidth <= 0 || dstHeight <= 0 {
+				continue
+			}
+
+			part := n.image.SubImage(image.Rect(srcX[col], srcY[row], srcX[col+1], srcY[row+1])).(*ebiten.Image)
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(dstWidth/float64(srcWidth), dstHeight/float64(srcHeight))
+			op.GeoM.Translate(dstX[col], dstY[row])
+			screen.DrawImage(part, op)
+		}
+	}
+}
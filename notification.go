@@ -0,0 +1,596 @@
+package ebui
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ InteractiveComponent = &Notification{}
+
+// NotificationSeverity determines the accent color of a notification
+type NotificationSeverity int
+
+const (
+	NotificationInfo NotificationSeverity = iota
+	NotificationSuccess
+	NotificationWarning
+	NotificationError
+)
+
+// NotificationPlacement is the screen corner notifications are stacked in
+type NotificationPlacement int
+
+const (
+	NotificationTopRight NotificationPlacement = iota
+	NotificationTopLeft
+	NotificationBottomRight
+	NotificationBottomLeft
+)
+
+// NotificationColors represents the color scheme for notifications
+type NotificationColors struct {
+	Background  color.Color
+	Text        color.Color
+	Action      color.Color
+	ActionHover color.Color
+	Info        color.Color
+	Success     color.Color
+	Warning     color.Color
+	Error       color.Color
+}
+
+// DefaultNotificationColors returns a default color scheme for notifications
+func DefaultNotificationColors() NotificationColors {
+	return NotificationColors{
+		Background:  color.RGBA{50, 50, 55, 240},
+		Text:        color.White,
+		Action:      color.RGBA{135, 180, 250, 255},
+		ActionHover: color.RGBA{80, 80, 90, 255},
+		Info:        color.RGBA{100, 149, 237, 255}, // Cornflower blue
+		Success:     color.RGBA{80, 180, 100, 255},
+		Warning:     color.RGBA{230, 170, 50, 255},
+		Error:       color.RGBA{220, 70, 70, 255},
+	}
+}
+
+// accentColor returns the color used for a severity
+func (c NotificationColors) accentColor(severity NotificationSeverity) color.Color {
+	switch severity {
+	case NotificationSuccess:
+		return c.Success
+	case NotificationWarning:
+		return c.Warning
+	case NotificationError:
+		return c.Error
+	default:
+		return c.Info
+	}
+}
+
+const (
+	notificationPadding      = 10.0
+	notificationAccentWidth  = 4.0
+	notificationActionHeight = 20.0
+	notificationActionGap    = 6.0
+	notificationSlide        = 40.0
+	notificationFadeDuration = 200 * time.Millisecond
+)
+
+type notificationAction struct {
+	label   string
+	handler func()
+}
+
+// Notification is a transient message shown by a NotificationManager
+type Notification struct {
+	*BaseInteractive
+	*BaseComponent
+	manager     *NotificationManager
+	title       string
+	message     string
+	severity    NotificationSeverity
+	duration    time.Duration
+	actions     []notificationAction
+	onDismiss   func()
+	lines       []string
+	elapsed     time.Duration // Time shown, not counting time spent hovered
+	lastUpdate  time.Time
+	shownAt     time.Time
+	dismissedAt time.Time // Zero until the notification starts to leave
+	targetY     float64
+	currentY    float64
+	isHovered   bool
+	hoverAction int
+	canvas      *ebiten.Image
+}
+
+// NotificationOpt is a function that configures a Notification
+type NotificationOpt func(n *Notification)
+
+// WithNotificationTitle shows a title above the message
+func WithNotificationTitle(title string) NotificationOpt {
+	return func(n *Notification) {
+		n.title = title
+	}
+}
+
+// WithSeverity sets the severity, which determines the accent color
+func WithSeverity(severity NotificationSeverity) NotificationOpt {
+	return func(n *Notification) {
+		n.severity = severity
+	}
+}
+
+// WithDuration sets how long the notification is shown before it is dismissed.
+// A duration of zero keeps it until it is clicked or dismissed.
+func WithDuration(duration time.Duration) NotificationOpt {
+	return func(n *Notification) {
+		n.duration = duration
+	}
+}
+
+// WithNotificationAction adds a button that calls the handler and dismisses the notification
+func WithNotificationAction(label string, handler func()) NotificationOpt {
+	return func(n *Notification) {
+		n.actions = append(n.actions, notificationAction{label: label, handler: handler})
+	}
+}
+
+// WithDismissHandler sets a function called once the notification has been dismissed
+func WithDismissHandler(handler func()) NotificationOpt {
+	return func(n *Notification) {
+		n.onDismiss = handler
+	}
+}
+
+func (n *Notification) registerEventListeners() {
+	n.AddEventListener(MouseEnter, func(e *Event) {
+		n.isHovered = true
+	})
+
+	n.AddEventListener(MouseLeave, func(e *Event) {
+		n.isHovered = false
+		n.hoverAction = -1
+	})
+
+	n.AddEventListener(MouseMove, func(e *Event) {
+		n.hoverAction = n.getActionAt(e.MouseX, e.MouseY)
+	})
+
+	n.AddEventListener(MouseUp, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft || n.IsDismissing() {
+			return
+		}
+		// Clicking an action runs it, clicking anywhere else just dismisses
+		if index := n.getActionAt(e.MouseX, e.MouseY); index != -1 && n.actions[index].handler != nil {
+			n.actions[index].handler()
+		}
+		n.Dismiss()
+	})
+}
+
+func (n *Notification) Update() error {
+	now := time.Now()
+	if !n.lastUpdate.IsZero() && !n.isHovered {
+		n.elapsed += now.Sub(n.lastUpdate)
+	}
+	n.lastUpdate = now
+
+	// Hovering pauses the timer so there is time to read the message or pick an action
+	if n.duration > 0 && n.elapsed >= n.duration && !n.IsDismissing() {
+		n.Dismiss()
+	}
+
+	return n.BaseComponent.Update()
+}
+
+// isFinished returns whether the notification has finished animating out and can be removed
+func (n *Notification) isFinished() bool {
+	return n.IsDismissing() && time.Since(n.dismissedAt) >= notificationFadeDuration
+}
+
+// getOpacity returns the fade in and out progress from 0 to 1
+func (n *Notification) getOpacity() float64 {
+	opacity := clamp(float64(time.Since(n.shownAt))/float64(notificationFadeDuration), 0, 1)
+	if n.IsDismissing() {
+		opacity = math.Min(opacity, 1-clamp(float64(time.Since(n.dismissedAt))/float64(notificationFadeDuration), 0, 1))
+	}
+	return opacity
+}
+
+// layoutText wraps the message to the notification width and sizes the notification to fit
+func (n *Notification) layoutText(width float64, face font.Face) {
+	textWidth := width - notificationAccentWidth - notificationPadding*2
+	message := []rune(n.message)
+	n.lines = nil
+	for _, span := range wrapText(face, message, textWidth, true) {
+		n.lines = append(n.lines, string(message[span.start:span.end]))
+	}
+
+	lineCount := len(n.lines)
+	if n.title != "" {
+		lineCount++
+	}
+	height := notificationPadding*2 + float64(lineCount*face.Metrics().Height.Ceil())
+	if len(n.actions) > 0 {
+		height += notificationActionGap + notificationActionHeight
+	}
+	n.SetSize(Size{Width: width, Height: height})
+}
+
+// getActionBounds returns the x, y and width of an action relative to the notification
+func (n *Notification) getActionBounds(index int) (float64, float64, float64) {
+	size := n.GetSize()
+	x := size.Width - notificationPadding
+	y := size.Height - notificationPadding - notificationActionHeight
+	for i := len(n.actions) - 1; i >= index; i-- {
+		width := float64(font.MeasureString(n.manager.font, n.actions[i].label).Ceil()) + 12
+		x -= width
+		if i == index {
+			return x, y, width
+		}
+		x -= notificationActionGap
+	}
+	return x, y, 0
+}
+
+// getActionAt returns the index of the action at an absolute position, or -1
+func (n *Notification) getActionAt(x, y float64) int {
+	if n.manager == nil {
+		return -1
+	}
+	pos := n.GetAbsolutePosition()
+	for i := range n.actions {
+		ax, ay, width := n.getActionBounds(i)
+		if x >= pos.X+ax && x < pos.X+ax+width && y >= pos.Y+ay && y < pos.Y+ay+notificationActionHeight {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n *Notification) Draw(screen *ebiten.Image) {
+	if n.IsHidden() || n.manager == nil {
+		return
+	}
+
+	size := n.GetSize()
+	if !size.IsDrawable() {
+		return
+	}
+
+	// Draw into an offscreen image first so the whole notification fades together
+	w, h := int(math.Ceil(size.Width)), int(math.Ceil(size.Height))
+	if n.canvas == nil || n.canvas.Bounds().Dx() != w || n.canvas.Bounds().Dy() != h {
+		n.canvas = ebiten.NewImage(w, h)
+	}
+	n.canvas.Clear()
+	n.drawContent(n.canvas)
+
+	pos := n.GetAbsolutePosition()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	op.ColorScale.ScaleAlpha(float32(n.getOpacity()))
+	screen.DrawImage(n.canvas, op)
+
+	n.drawDebug(screen)
+}
+
+func (n *Notification) drawContent(dst *ebiten.Image) {
+	size := n.GetSize()
+	colors := n.manager.colors
+	face := n.manager.font
+
+	bg := GetCache().ImageWithColor(int(size.Width), int(size.Height), colors.Background)
+	dst.DrawImage(bg, nil)
+
+	accent := GetCache().ImageWithColor(int(notificationAccentWidth), int(size.Height), colors.accentColor(n.severity))
+	dst.DrawImage(accent, nil)
+
+	metrics := face.Metrics()
+	lineHeight := metrics.Height.Ceil()
+	x := int(notificationAccentWidth + notificationPadding)
+	y := int(notificationPadding) + metrics.Ascent.Ceil()
+	if n.title != "" {
+		text.Draw(dst, n.title, face, x, y, colors.accentColor(n.severity))
+		y += lineHeight
+	}
+	for _, line := range n.lines {
+		text.Draw(dst, line, face, x, y, colors.Text)
+		y += lineHeight
+	}
+
+	for i, action := range n.actions {
+		ax, ay, width := n.getActionBounds(i)
+		if i == n.hoverAction {
+			hover := GetCache().ImageWithColor(int(width), int(notificationActionHeight), colors.ActionHover)
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(ax, ay)
+			dst.DrawImage(hover, op)
+		}
+		baseline := int(ay + (notificationActionHeight-float64(lineHeight))/2 + float64(metrics.Ascent.Ceil()))
+		text.Draw(dst, action.label, face, int(ax+6), baseline, colors.Action)
+	}
+}
+
+// Dismiss starts hiding the notification. Queued notifications are removed immediately.
+func (n *Notification) Dismiss() {
+	if n.manager != nil {
+		n.manager.Dismiss(n)
+	}
+}
+
+// IsDismissing returns whether the notification is animating out
+func (n *Notification) IsDismissing() bool {
+	return !n.dismissedAt.IsZero()
+}
+
+// GetMessage returns the notification message
+func (n *Notification) GetMessage() string {
+	return n.message
+}
+
+// GetSeverity returns the notification severity
+func (n *Notification) GetSeverity() NotificationSeverity {
+	return n.severity
+}
+
+// NotificationManager is an overlay that shows notifications stacked in a corner of the screen.
+// Add it to the root container after all other components so notifications are drawn on top.
+// Notifications beyond the maximum visible count are queued until others are dismissed.
+type NotificationManager struct {
+	*ZIndexedContainer
+	placement       NotificationPlacement
+	maxVisible      int
+	width           float64
+	spacing         float64
+	margin          float64
+	defaultDuration time.Duration
+	colors          NotificationColors
+	font            font.Face
+	visible         []*Notification
+	queue           []*Notification
+}
+
+// WithNotificationPlacement sets the corner notifications are stacked in
+func WithNotificationPlacement(placement NotificationPlacement) ComponentOpt {
+	return func(c Component) {
+		if nm, ok := c.(*NotificationManager); ok {
+			nm.placement = placement
+		}
+	}
+}
+
+// WithMaxVisible sets how many notifications are shown at once before new ones are queued
+func WithMaxVisible(count int) ComponentOpt {
+	return func(c Component) {
+		if nm, ok := c.(*NotificationManager); ok {
+			nm.maxVisible = count
+		}
+	}
+}
+
+// WithNotificationWidth sets the width of each notification
+func WithNotificationWidth(width float64) ComponentOpt {
+	return func(c Component) {
+		if nm, ok := c.(*NotificationManager); ok {
+			nm.width = width
+		}
+	}
+}
+
+// WithDefaultDuration sets how long notifications are shown when they don't set a duration
+func WithDefaultDuration(duration time.Duration) ComponentOpt {
+	return func(c Component) {
+		if nm, ok := c.(*NotificationManager); ok {
+			nm.defaultDuration = duration
+		}
+	}
+}
+
+// WithNotificationColors sets the colors for notifications
+func WithNotificationColors(colors NotificationColors) ComponentOpt {
+	return func(c Component) {
+		if nm, ok := c.(*NotificationManager); ok {
+			nm.colors = colors
+		}
+	}
+}
+
+// NewNotificationManager creates a new notification manager
+func NewNotificationManager(opts ...ComponentOpt) *NotificationManager {
+	nm := &NotificationManager{
+		ZIndexedContainer: NewZIndexedContainer(opts...),
+		placement:         NotificationTopRight,
+		maxVisible:        3,
+		width:             280,
+		spacing:           8,
+		margin:            16,
+		defaultDuration:   3 * time.Second,
+		colors:            DefaultNotificationColors(),
+		font:              basicfont.Face7x13,
+	}
+
+	for _, opt := range opts {
+		opt(nm)
+	}
+
+	return nm
+}
+
+// Notify shows a notification with the given message, or queues it if the maximum number are visible
+func (nm *NotificationManager) Notify(message string, opts ...NotificationOpt) *Notification {
+	n := &Notification{
+		BaseInteractive: NewBaseInteractive(),
+		BaseComponent:   NewBaseComponent(),
+		manager:         nm,
+		message:         message,
+		severity:        NotificationInfo,
+		duration:        nm.defaultDuration,
+		hoverAction:     -1,
+	}
+
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	n.layoutText(nm.width, nm.font)
+	n.registerEventListeners()
+
+	if nm.maxVisible > 0 && len(nm.visible) >= nm.maxVisible {
+		nm.queue = append(nm.queue, n)
+	} else {
+		nm.show(n)
+	}
+	return n
+}
+
+// show adds a notification to the visible stack
+func (nm *NotificationManager) show(n *Notification) {
+	n.shownAt = time.Now()
+	n.lastUpdate = time.Time{}
+	nm.visible = append(nm.visible, n)
+	nm.layout()
+	n.currentY = n.targetY
+	nm.AddChild(n)
+}
+
+// Dismiss starts hiding a visible notification, or removes a queued one
+func (nm *NotificationManager) Dismiss(n *Notification) {
+	for i, queued := range nm.queue {
+		if queued == n {
+			nm.queue = append(nm.queue[:i], nm.queue[i+1:]...)
+			n.manager = nil
+			if n.onDismiss != nil {
+				n.onDismiss()
+			}
+			return
+		}
+	}
+
+	if !n.IsDismissing() {
+		n.dismissedAt = time.Now()
+	}
+}
+
+// DismissAll dismisses every visible notification and clears the queue
+func (nm *NotificationManager) DismissAll() {
+	for len(nm.queue) > 0 {
+		nm.Dismiss(nm.queue[0])
+	}
+	for _, n := range nm.visible {
+		nm.Dismiss(n)
+	}
+}
+
+// remove takes a notification off the screen once it has finished animating out
+// and shows the next queued notification
+func (nm *NotificationManager) remove(n *Notification) {
+	for i, visible := range nm.visible {
+		if visible != n {
+			continue
+		}
+		nm.visible = append(nm.visible[:i], nm.visible[i+1:]...)
+		nm.RemoveChild(n)
+		n.manager = nil
+		if n.onDismiss != nil {
+			n.onDismiss()
+		}
+		break
+	}
+
+	for len(nm.queue) > 0 && (nm.maxVisible <= 0 || len(nm.visible) < nm.maxVisible) {
+		next := nm.queue[0]
+		nm.queue = nm.queue[1:]
+		nm.show(next)
+	}
+	nm.layout()
+}
+
+// layout computes where each visible notification belongs, stacking away from the corner
+func (nm *NotificationManager) layout() {
+	bounds := nm.GetSize()
+	top := nm.placement == NotificationTopRight || nm.placement == NotificationTopLeft
+
+	offset := nm.margin
+	for _, n := range nm.visible {
+		height := n.GetSize().Height
+		if top {
+			n.targetY = offset
+		} else {
+			n.targetY = bounds.Height - offset - height
+		}
+		offset += height + nm.spacing
+	}
+}
+
+func (nm *NotificationManager) Update() error {
+	nm.layout()
+
+	pos := nm.GetAbsolutePosition()
+	bounds := nm.GetSize()
+	left := nm.placement == NotificationTopLeft || nm.placement == NotificationBottomLeft
+
+	for _, n := range nm.visible {
+		// Slide towards the stacked position, so the stack closes up when one is removed
+		n.currentY += (n.targetY - n.currentY) * 0.3
+		if math.Abs(n.targetY-n.currentY) < 0.5 {
+			n.currentY = n.targetY
+		}
+
+		// Slide in from and out towards the screen edge while fading
+		slide := (1 - n.getOpacity()) * notificationSlide
+		x := bounds.Width - nm.margin - nm.width + slide
+		if left {
+			x = nm.margin - slide
+		}
+		n.SetPosition(Position{X: pos.X + x, Y: pos.Y + n.currentY})
+	}
+
+	if err := nm.ZIndexedContainer.Update(); err != nil {
+		return err
+	}
+
+	// Finished notifications are removed after the children have updated, so the children are not changed while updating
+	var finished []*Notification
+	for _, n := range nm.visible {
+		if n.isFinished() {
+			finished = append(finished, n)
+		}
+	}
+	for _, n := range finished {
+		nm.remove(n)
+	}
+	return nil
+}
+
+// GetVisible returns the notifications currently on screen
+func (nm *NotificationManager) GetVisible() []*Notification {
+	return nm.visible
+}
+
+// GetQueued returns the notifications waiting to be shown
+func (nm *NotificationManager) GetQueued() []*Notification {
+	return nm.queue
+}
+
+// SetPlacement sets the corner notifications are stacked in
+func (nm *NotificationManager) SetPlacement(placement NotificationPlacement) {
+	nm.placement = placement
+}
+
+// SetFont sets the font used for new notifications
+func (nm *NotificationManager) SetFont(font font.Face) {
+	nm.font = font
+}
+
+// SetColors sets the color scheme for notifications
+func (nm *NotificationManager) SetColors(colors NotificationColors) {
+	nm.colors = colors
+}
@@ -0,0 +1,109 @@
+package ebui
+
+import "time"
+
+// SetPointerCapture routes all pointer events to the component, regardless of hit testing,
+// until every mouse button is released or ReleasePointerCapture is called.
+// While captured, the component receives MouseEnter and MouseLeave as the pointer enters and leaves its bounds,
+// and other components receive no pointer events apart from drag-and-drop events.
+// A component that loses the capture receives LostPointerCapture.
+func (im *InputManager) SetPointerCapture(component InteractiveComponent) {
+	if im.pointerCapture == component {
+		return
+	}
+	im.releasePointerCapture()
+	im.pointerCapture = component
+}
+
+// ReleasePointerCapture releases the pointer capture if it is held by the component
+func (im *InputManager) ReleasePointerCapture(component InteractiveComponent) {
+	if component != nil && im.pointerCapture == component {
+		im.releasePointerCapture()
+	}
+}
+
+// GetPointerCapture returns the component that has captured the pointer, or nil if there is none
+func (im *InputManager) GetPointerCapture() InteractiveComponent {
+	return im.pointerCapture
+}
+
+// HasPointerCapture returns whether the component has captured the pointer
+func (im *InputManager) HasPointerCapture(component InteractiveComponent) bool {
+	return component != nil && im.pointerCapture == component
+}
+
+// releasePointerCapture clears the pointer capture and notifies the component that held it
+func (im *InputManager) releasePointerCapture() {
+	captured := im.pointerCapture
+	if captured == nil {
+		return
+	}
+	im.pointerCapture = nil
+
+	lostEvent := Event{
+		Type:      LostPointerCapture,
+		Target:    captured,
+		MouseX:    im.lastMouseX,
+		MouseY:    im.lastMouseY,
+		Timestamp: time.Now().UnixNano(),
+	}
+	im.dispatchEvent(&lostEvent)
+}
+
+// getPointerCapturePath returns the event path to the component that has captured the pointer.
+// It returns false if the component can no longer receive input, because it was removed from the tree,
+// disabled, or lies outside the active input trap.
+func (im *InputManager) getPointerCapturePath(root Component, trap Component) ([]InteractiveComponent, bool) {
+	if im.pointerCapture.IsDisabled() {
+		return nil, false
+	}
+	if trap != nil && !containsComponent(trap, im.pointerCapture) {
+		return nil, false
+	}
+	return findComponentPath(root, im.pointerCapture, nil)
+}
+
+// findComponentPath returns the event path from root to target
+func findComponentPath(root Component, target Component, currentPath []InteractiveComponent) ([]InteractiveComponent, bool) {
+	if root == nil || root.IsDisabled() {
+		return nil, false
+	}
+
+	if interactive, ok := root.(InteractiveComponent); ok {
+		currentPath = append(currentPath, interactive)
+	}
+
+	if root == target {
+		return currentPath, true
+	}
+
+	if container, ok := root.(Container); ok {
+		for _, child := range container.GetChildren() {
+			if path, ok := findComponentPath(child, target, currentPath); ok {
+				return path, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// SetPointerCapture routes all pointer events to the component until every mouse button is released.
+// It is intended to be called from a MouseDown handler. See InputManager.SetPointerCapture.
+func (e *Event) SetPointerCapture(component InteractiveComponent) {
+	if e.inputManager != nil {
+		e.inputManager.SetPointerCapture(component)
+	}
+}
+
+// ReleasePointerCapture releases the pointer capture if it is held by the component
+func (e *Event) ReleasePointerCapture(component InteractiveComponent) {
+	if e.inputManager != nil {
+		e.inputManager.ReleasePointerCapture(component)
+	}
+}
+
+// HasPointerCapture returns whether the component has captured the pointer
+func (e *Event) HasPointerCapture(component InteractiveComponent) bool {
+	return e.inputManager != nil && e.inputManager.HasPointerCapture(component)
+}
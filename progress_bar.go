@@ -0,0 +1,314 @@
+package ebui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ Component = &ProgressBar{}
+
+// ProgressBarColors represents the color scheme for a progress bar
+type ProgressBarColors struct {
+	Background color.Color
+	Fill       color.Color
+	Text       color.Color
+}
+
+// DefaultProgressBarColors returns a default color scheme for progress bars
+func DefaultProgressBarColors() ProgressBarColors {
+	return ProgressBarColors{
+		Background: color.RGBA{200, 200, 200, 255},
+		Fill:       color.RGBA{100, 149, 237, 255}, // Cornflower blue
+		Text:       color.Black,
+	}
+}
+
+// ProgressBar displays progress towards completion, either as a determinate value
+// or as an animated indeterminate indicator
+type ProgressBar struct {
+	*BaseComponent
+	min              float64
+	max              float64
+	value            float64
+	displayed        float64 // Value currently drawn, which animates towards value
+	speed            float64 // Fraction of the range the displayed value moves per update
+	orientation      Orientation
+	colors           ProgressBarColors
+	font             font.Face
+	showText         bool
+	textFormat       func(fraction float64) string
+	segments         int
+	segmentGap       float64
+	indeterminate    bool
+	indeterminatePos float64 // Position of the moving block, from -indeterminateWidth to 1
+}
+
+const (
+	indeterminateWidth = 0.3
+	indeterminateSpeed = 0.015
+)
+
+// WithProgressBarColors sets the colors for the progress bar
+func WithProgressBarColors(colors ProgressBarColors) ComponentOpt {
+	return func(c Component) {
+		if p, ok := c.(*ProgressBar); ok {
+			p.colors = colors
+		}
+	}
+}
+
+// WithProgressText draws the progress as text over the bar.
+// The format function receives the filled fraction from 0 to 1 and may be nil to show a percentage.
+func WithProgressText(format func(fraction float64) string) ComponentOpt {
+	return func(c Component) {
+		if p, ok := c.(*ProgressBar); ok {
+			p.showText = true
+			p.textFormat = format
+		}
+	}
+}
+
+// WithSegments splits the bar into the given number of blocks separated by a gap
+func WithSegments(count int, gap float64) ComponentOpt {
+	return func(c Component) {
+		if p, ok := c.(*ProgressBar); ok {
+			p.segments = count
+			p.segmentGap = gap
+		}
+	}
+}
+
+// WithIndeterminate makes the progress bar show an animated indicator instead of a value
+func WithIndeterminate() ComponentOpt {
+	return func(c Component) {
+		if p, ok := c.(*ProgressBar); ok {
+			p.indeterminate = true
+		}
+	}
+}
+
+// NewProgressBar creates a new progress bar.
+// Use WithMinValue, WithMaxValue and WithValue to configure the value, and WithOrientation for vertical bars.
+func NewProgressBar(opts ...ComponentOpt) *ProgressBar {
+	p := &ProgressBar{
+		BaseComponent:    NewBaseComponent(opts...),
+		min:              0,
+		max:              100,
+		value:            0,
+		speed:            0.05,
+		orientation:      OrientationHorizontal,
+		colors:           DefaultProgressBarColors(),
+		font:             basicfont.Face7x13,
+		indeterminatePos: -indeterminateWidth,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	// The initial value is shown without animating
+	p.value = clamp(p.value, p.min, p.max)
+	p.displayed = p.value
+
+	return p
+}
+
+func (p *ProgressBar) Update() error {
+	if p.indeterminate {
+		p.indeterminatePos += indeterminateSpeed
+		if p.indeterminatePos > 1 {
+			p.indeterminatePos = -indeterminateWidth
+		}
+	} else if p.displayed != p.value {
+		// Move the displayed value towards the target at a constant rate
+		step := p.speed * (p.max - p.min)
+		if p.speed <= 0 || math.Abs(p.value-p.displayed) <= step {
+			p.displayed = p.value
+		} else if p.displayed < p.value {
+			p.displayed += step
+		} else {
+			p.displayed -= step
+		}
+	}
+	return p.BaseComponent.Update()
+}
+
+func (p *ProgressBar) Draw(screen *ebiten.Image) {
+	if p.IsHidden() {
+		return
+	}
+
+	pos := p.GetAbsolutePosition()
+	size := p.GetSize()
+	if !size.IsDrawable() {
+		return
+	}
+
+	bg := GetCache().ImageWithColor(int(size.Width), int(size.Height), p.colors.Background)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(bg, op)
+
+	if p.indeterminate {
+		start := math.Max(p.indeterminatePos, 0)
+		end := math.Min(p.indeterminatePos+indeterminateWidth, 1)
+		p.drawFill(screen, start, end)
+	} else {
+		p.drawFill(screen, 0, p.getDisplayedFraction())
+		if p.showText {
+			p.drawText(screen)
+		}
+	}
+
+	p.drawDebug(screen)
+}
+
+// drawFill fills the span of the bar between two fractions of its length,
+// measured from the left of horizontal bars and the bottom of vertical ones
+func (p *ProgressBar) drawFill(screen *ebiten.Image, start, end float64) {
+	if end <= start {
+		return
+	}
+
+	if p.segments <= 1 {
+		p.drawSpan(screen, start, end)
+		return
+	}
+
+	// Each segment is filled by the part of the span that overlaps it
+	length := p.getLength()
+	segmentLength := (length - p.segmentGap*float64(p.segments-1)) / float64(p.segments)
+	if segmentLength <= 0 {
+		return
+	}
+	for i := 0; i < p.segments; i++ {
+		segStart := float64(i) * (segmentLength + p.segmentGap) / length
+		segEnd := segStart + segmentLength/length
+		p.drawSpan(screen, math.Max(start, segStart), math.Min(end, segEnd))
+	}
+}
+
+// drawSpan draws a single filled rectangle between two fractions of the bar's length
+func (p *ProgressBar) drawSpan(screen *ebiten.Image, start, end float64) {
+	length := p.getLength()
+	from := math.Round(start * length)
+	to := math.Round(end * length)
+	if to <= from {
+		return
+	}
+
+	pos := p.GetAbsolutePosition()
+	size := p.GetSize()
+	op := &ebiten.DrawImageOptions{}
+	var fill *ebiten.Image
+	if p.orientation == OrientationVertical {
+		// Vertical bars fill upwards
+		fill = GetCache().ImageWithColor(int(size.Width), int(to-from), p.colors.Fill)
+		op.GeoM.Translate(pos.X, pos.Y+size.Height-to)
+	} else {
+		fill = GetCache().ImageWithColor(int(to-from), int(size.Height), p.colors.Fill)
+		op.GeoM.Translate(pos.X+from, pos.Y)
+	}
+	screen.DrawImage(fill, op)
+}
+
+func (p *ProgressBar) drawText(screen *ebiten.Image) {
+	label := p.getText()
+	if label == "" {
+		return
+	}
+
+	pos := p.GetAbsolutePosition()
+	size := p.GetSize()
+	metrics := p.font.Metrics()
+	width := float64(font.MeasureString(p.font, label).Ceil())
+	x := pos.X + (size.Width-width)/2
+	y := pos.Y + (size.Height-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil())
+
+	text.Draw(screen, label, p.font, int(x), int(y), p.colors.Text)
+}
+
+func (p *ProgressBar) getText() string {
+	fraction := p.getDisplayedFraction()
+	if p.textFormat != nil {
+		return p.textFormat(fraction)
+	}
+	return fmt.Sprintf("%.0f%%", fraction*100)
+}
+
+// getLength returns the size of the bar along its fill direction
+func (p *ProgressBar) getLength() float64 {
+	if p.orientation == OrientationVertical {
+		return p.GetSize().Height
+	}
+	return p.GetSize().Width
+}
+
+func (p *ProgressBar) toFraction(value float64) float64 {
+	if p.max <= p.min {
+		return 0
+	}
+	return clamp((value-p.min)/(p.max-p.min), 0, 1)
+}
+
+func (p *ProgressBar) getDisplayedFraction() float64 {
+	return p.toFraction(p.displayed)
+}
+
+// SetValue sets the progress value, clamped into range.
+// The bar animates towards the new value at the transition speed.
+func (p *ProgressBar) SetValue(value float64) {
+	p.value = clamp(value, p.min, p.max)
+}
+
+// GetValue returns the current progress value
+func (p *ProgressBar) GetValue() float64 {
+	return p.value
+}
+
+// GetFraction returns the progress as a fraction from 0 to 1
+func (p *ProgressBar) GetFraction() float64 {
+	return p.toFraction(p.value)
+}
+
+// SetRange sets the minimum and maximum values, clamping the current value into the new range
+func (p *ProgressBar) SetRange(min, max float64) {
+	p.min = min
+	p.max = max
+	p.value = clamp(p.value, min, max)
+	p.displayed = clamp(p.displayed, min, max)
+}
+
+// GetRange returns the minimum and maximum values
+func (p *ProgressBar) GetRange() (float64, float64) {
+	return p.min, p.max
+}
+
+// SetIndeterminate switches between the animated indeterminate indicator and the determinate value
+func (p *ProgressBar) SetIndeterminate(indeterminate bool) {
+	if indeterminate && !p.indeterminate {
+		p.indeterminatePos = -indeterminateWidth
+	}
+	p.indeterminate = indeterminate
+}
+
+// IsIndeterminate returns whether the progress bar shows the indeterminate indicator
+func (p *ProgressBar) IsIndeterminate() bool {
+	return p.indeterminate
+}
+
+// SetFont sets the font used for the progress text
+func (p *ProgressBar) SetFont(font font.Face) {
+	p.font = font
+}
+
+// SetColors sets the color scheme for the progress bar
+func (p *ProgressBar) SetColors(colors ProgressBarColors) {
+	p.colors = colors
+}
@@ -78,6 +78,11 @@ func NewScrollableContainer(opts ...ComponentOpt) *ScrollableContainer {
 func (sc *ScrollableContainer) registerEventListeners() {
 	// Handle mouse wheel events
 	sc.AddEventListener(Wheel, func(e *Event) {
+		// The wheel is sent to the innermost scrollable component, such as a text area or spin box
+		// within the container, which handles it instead
+		if e.Phase != PhaseTarget {
+			return
+		}
 		wheelY := e.WheelDeltaY
 		scrollOffset := sc.GetScrollOffset()
 		scrollOffset.Y -= wheelY * 10
@@ -86,7 +91,7 @@ func (sc *ScrollableContainer) registerEventListeners() {
 
 	// Handle scroll bar dragging
 	sc.AddEventListener(DragStart, func(e *Event) {
-		if sc.isOverScrollBar(e.MouseX, e.MouseY) {
+		if e.MouseButton == ebiten.MouseButtonLeft && sc.isOverScrollBar(e.MouseX, e.MouseY) {
 			sc.isDraggingThumb = true
 			sc.dragStartY = e.MouseY
 			sc.dragStartOffset = sc.scrollOffset.Y
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
 )
 
 var _ FocusableComponent = &Slider{}
@@ -21,6 +23,8 @@ type SliderColors struct {
 	ThumbHovered color.Color
 	ThumbDragged color.Color
 	FocusBorder  color.Color
+	Tick         color.Color
+	TickLabel    color.Color
 }
 
 // DefaultSliderColors returns a default color scheme for sliders
@@ -32,6 +36,8 @@ func DefaultSliderColors() SliderColors {
 		ThumbHovered: color.RGBA{230, 230, 230, 255},
 		ThumbDragged: color.RGBA{220, 220, 220, 255},
 		FocusBorder:  color.Black,
+		Tick:         color.RGBA{120, 120, 120, 255},
+		TickLabel:    color.RGBA{80, 80, 80, 255},
 	}
 }
 
@@ -56,6 +62,19 @@ type Slider struct {
 	valueSuffix string
 	focusable   bool
 	tabIndex    int
+	orientation Orientation
+
+	// Range mode uses a second thumb for the lower value
+	rangeMode     bool
+	lowValue      float64
+	activeThumb   sliderThumb
+	onRangeChange func(low, high float64)
+
+	// Tick marks
+	tickInterval   float64
+	showTickLabels bool
+	tickFormat     func(value float64) string
+	snapToTicks    bool
 
 	// Key repeat tracking for continuous sliding
 	repeatKey   ebiten.Key
@@ -63,40 +82,66 @@ type Slider struct {
 	lastRepeat  time.Time
 }
 
+// sliderThumb identifies one of the thumbs of a range slider
+type sliderThumb int
+
+const (
+	sliderThumbHigh sliderThumb = iota
+	sliderThumbLow
+)
+
 // SliderOpt is a function that configures a Slider
 type SliderOpt func(s *Slider)
 
-// WithMinValue sets the minimum value for the slider
+// WithMinValue sets the minimum value for the slider, spin box or progress bar
 func WithMinValue(min float64) ComponentOpt {
 	return func(c Component) {
-		if s, ok := c.(*Slider); ok {
+		switch s := c.(type) {
+		case *Slider:
+			s.min = min
+		case *SpinBox:
+			s.min = min
+		case *ProgressBar:
 			s.min = min
 		}
 	}
 }
 
-// WithMaxValue sets the maximum value for the slider
+// WithMaxValue sets the maximum value for the slider, spin box or progress bar
 func WithMaxValue(max float64) ComponentOpt {
 	return func(c Component) {
-		if s, ok := c.(*Slider); ok {
+		switch s := c.(type) {
+		case *Slider:
+			s.max = max
+		case *SpinBox:
+			s.max = max
+		case *ProgressBar:
 			s.max = max
 		}
 	}
 }
 
-// WithValue sets the initial value for the slider
+// WithValue sets the initial value for the slider, spin box or progress bar
 func WithValue(value float64) ComponentOpt {
 	return func(c Component) {
-		if s, ok := c.(*Slider); ok {
+		switch s := c.(type) {
+		case *Slider:
 			s.SetValue(value)
+		case *SpinBox:
+			s.value = value
+		case *ProgressBar:
+			s.value = value
 		}
 	}
 }
 
-// WithStepSize sets the step size for the slider
+// WithStepSize sets the step size for the slider or spin box
 func WithStepSize(step float64) ComponentOpt {
 	return func(c Component) {
-		if s, ok := c.(*Slider); ok {
+		switch s := c.(type) {
+		case *Slider:
+			s.stepSize = step
+		case *SpinBox:
 			s.stepSize = step
 		}
 	}
@@ -133,8 +178,11 @@ func WithSliderColors(colors SliderColors) ComponentOpt {
 // WithOnChangeHandler sets the handler for value changes
 func WithOnChangeHandler(handler func(value float64)) ComponentOpt {
 	return func(c Component) {
-		if s, ok := c.(*Slider); ok {
+		switch s := c.(type) {
+		case *Slider:
 			s.onChange = handler
+		case *SpinBox:
+			s.onValueChange = handler
 		}
 	}
 }
@@ -157,6 +205,71 @@ func WithValueSuffix(suffix string) ComponentOpt {
 	}
 }
 
+// WithOrientation sets whether a slider, progress bar or split pane runs horizontally or vertically.
+// Vertical sliders and progress bars have their minimum value at the bottom, and vertical split
+// panes stack their panes top and bottom.
+func WithOrientation(orientation Orientation) ComponentOpt {
+	return func(c Component) {
+		switch s := c.(type) {
+		case *Slider:
+			s.orientation = orientation
+		case *ProgressBar:
+			s.orientation = orientation
+		case *SplitPane:
+			s.orientation = orientation
+		}
+	}
+}
+
+// WithRangeMode gives the slider two thumbs for selecting a range of values
+func WithRangeMode(low, high float64) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*Slider); ok {
+			s.rangeMode = true
+			s.lowValue = low
+			s.value = high
+		}
+	}
+}
+
+// WithRangeChangeHandler sets the handler for range changes in range mode
+func WithRangeChangeHandler(handler func(low, high float64)) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*Slider); ok {
+			s.onRangeChange = handler
+		}
+	}
+}
+
+// WithTickInterval draws tick marks along the track at the given interval, starting from the minimum value
+func WithTickInterval(interval float64) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*Slider); ok {
+			s.tickInterval = interval
+		}
+	}
+}
+
+// WithTickLabels draws a label next to each tick mark.
+// The format function may be nil to use the default number formatting.
+func WithTickLabels(format func(value float64) string) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*Slider); ok {
+			s.showTickLabels = true
+			s.tickFormat = format
+		}
+	}
+}
+
+// WithSnapToTicks makes the slider values snap to the tick marks instead of the step size
+func WithSnapToTicks() ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*Slider); ok {
+			s.snapToTicks = true
+		}
+	}
+}
+
 // NewSlider creates a new slider component
 func NewSlider(opts ...ComponentOpt) *Slider {
 	// Default layout is a horizontal container
@@ -179,6 +292,9 @@ func NewSlider(opts ...ComponentOpt) *Slider {
 		valueSuffix:   "",
 		focusable:     true,
 		tabIndex:      0,
+		orientation:   OrientationHorizontal,
+		activeThumb:   sliderThumbHigh,
+		onRangeChange: func(low, high float64) {},
 		repeatKey:     -1,
 	}
 
@@ -194,6 +310,12 @@ func NewSlider(opts ...ComponentOpt) *Slider {
 		opt(s)
 	}
 
+	if s.rangeMode {
+		// Range values are set before the bounds may be known, so clamp them now
+		s.value = clamp(s.value, s.min, s.max)
+		s.lowValue = clamp(s.lowValue, s.min, s.value)
+	}
+
 	// We'll handle the value label differently - we won't add it as a child
 	// to avoid it being drawn behind the slider
 
@@ -211,22 +333,36 @@ func (s *Slider) registerEventListeners() {
 		s.isHovered = false
 	})
 
-	s.AddEventListener(DragStart, func(e *Event) {
+	s.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
 		// Check if user clicked on the track or thumb
 		if s.isPointOverThumb(e.MouseX, e.MouseY) || s.isPointOverTrack(e.MouseX, e.MouseY) {
 			s.isDragging = true
+			e.SetPointerCapture(s)
+			if s.rangeMode {
+				s.activeThumb = s.getNearestThumb(e.MouseX, e.MouseY)
+			}
 			// Update slider value based on click position
-			s.updateValueFromPosition(e.MouseX)
+			s.updateValueFromPosition(e.MouseX, e.MouseY)
+		}
+	})
+
+	s.AddEventListener(MouseUp, func(e *Event) {
+		if e.MouseButton == ebiten.MouseButtonLeft {
+			s.isDragging = false
 		}
 	})
 
-	s.AddEventListener(DragEnd, func(e *Event) {
+	s.AddEventListener(LostPointerCapture, func(e *Event) {
 		s.isDragging = false
 	})
 
-	s.AddEventListener(Drag, func(e *Event) {
+	// The pointer is captured while dragging, so moves outside the slider still reach it
+	s.AddEventListener(MouseMove, func(e *Event) {
 		if s.isDragging {
-			s.updateValueFromPosition(e.MouseX)
+			s.updateValueFromPosition(e.MouseX, e.MouseY)
 		}
 	})
 
@@ -249,25 +385,39 @@ func (s *Slider) handleInput() {
 		return
 	}
 
-	// Handle keyboard navigation
-	if inpututil.IsKeyJustPressed(ebiten.KeyLeft) || inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+	// Handle keyboard navigation. Up and Right increase the value for both orientations.
+	if isAnyKeyJustPressed(sliderDecrementKeys) {
 		s.decrementValue()
-	} else if inpututil.IsKeyJustPressed(ebiten.KeyRight) || inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+	} else if isAnyKeyJustPressed(sliderIncrementKeys) {
 		s.incrementValue()
 	} else if inpututil.IsKeyJustPressed(ebiten.KeyHome) {
-		s.SetValue(s.min)
+		s.setThumbValue(s.activeThumb, s.min)
 	} else if inpututil.IsKeyJustPressed(ebiten.KeyEnd) {
-		s.SetValue(s.max)
+		s.setThumbValue(s.activeThumb, s.max)
 	} else if inpututil.IsKeyJustPressed(ebiten.KeyPageDown) {
-		s.SetValue(s.value - (s.max-s.min)/10)
+		s.setThumbValue(s.activeThumb, s.getThumbValue(s.activeThumb)-(s.max-s.min)/10)
 	} else if inpututil.IsKeyJustPressed(ebiten.KeyPageUp) {
-		s.SetValue(s.value + (s.max-s.min)/10)
+		s.setThumbValue(s.activeThumb, s.getThumbValue(s.activeThumb)+(s.max-s.min)/10)
 	}
 
 	// Handle key repeats for continuous sliding when holding down keys
 	s.handleKeyRepeat()
 }
 
+var (
+	sliderDecrementKeys = []ebiten.Key{ebiten.KeyLeft, ebiten.KeyDown}
+	sliderIncrementKeys = []ebiten.Key{ebiten.KeyRight, ebiten.KeyUp}
+)
+
+func isAnyKeyJustPressed(keys []ebiten.Key) bool {
+	for _, key := range keys {
+		if inpututil.IsKeyJustPressed(key) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Slider) Draw(screen *ebiten.Image) {
 	if s.isFocused {
 		// Draw the focus border 1px
@@ -282,9 +432,15 @@ func (s *Slider) Draw(screen *ebiten.Image) {
 	// Let the container handle drawing of any children (like the value label)
 	s.LayoutContainer.Draw(screen)
 
-	// Draw the slider track and thumb
+	// Draw the slider track, ticks and thumbs
 	s.drawTrack(screen)
-	s.drawThumb(screen)
+	if s.tickInterval > 0 {
+		s.drawTicks(screen)
+	}
+	if s.rangeMode {
+		s.drawThumb(screen, sliderThumbLow)
+	}
+	s.drawThumb(screen, sliderThumbHigh)
 
 	// Draw the value label if needed
 	if s.showValue {
@@ -293,33 +449,89 @@ func (s *Slider) Draw(screen *ebiten.Image) {
 }
 
 func (s *Slider) drawTrack(screen *ebiten.Image) {
-	pos := s.GetAbsolutePosition()
-	size := s.GetSize()
+	// Background track
+	s.drawAlongTrack(screen, s.getTrackStart(), s.getTrackLength(), s.colors.Track)
 
-	// Calculate track position
-	trackY := pos.Y + (size.Height-s.trackHeight)/2
+	// Filled track, from the minimum to the thumb or between the two thumbs in range mode
+	from := s.getTrackPosition(s.min)
+	if s.rangeMode {
+		from = s.getThumbPosition(sliderThumbLow)
+	}
+	to := s.getThumbPosition(sliderThumbHigh)
+	s.drawAlongTrack(screen, math.Min(from, to), math.Abs(to-from), s.colors.TrackFilled)
+}
 
-	// Background track
-	trackWidth := s.getTrackWidth()
-	trackImg := GetCache().ImageWithColor(int(trackWidth), int(s.trackHeight), s.colors.Track)
+// drawAlongTrack draws a track-thickness rectangle covering the given span of the slider's axis
+func (s *Slider) drawAlongTrack(screen *ebiten.Image, start, length float64, col color.Color) {
+	if int(length) <= 0 {
+		return
+	}
+
+	cross := s.getCrossCenter() - s.trackHeight/2
 	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(s.getTrackX(), trackY)
-	screen.DrawImage(trackImg, op)
+	var img *ebiten.Image
+	if s.orientation == OrientationVertical {
+		img = GetCache().ImageWithColor(int(s.trackHeight), int(length), col)
+		op.GeoM.Translate(cross, start)
+	} else {
+		img = GetCache().ImageWithColor(int(length), int(s.trackHeight), col)
+		op.GeoM.Translate(start, cross)
+	}
+	screen.DrawImage(img, op)
+}
+
+// drawTicks draws tick marks, and optionally their labels, beside the track
+func (s *Slider) drawTicks(screen *ebiten.Image) {
+	tickColor := s.colors.Tick
+	if tickColor == nil {
+		tickColor = DefaultSliderColors().Tick
+	}
+	labelColor := s.colors.TickLabel
+	if labelColor == nil {
+		labelColor = DefaultSliderColors().TickLabel
+	}
+
+	tickLength := 4.0
+	tickStart := s.getCrossCenter() + s.thumbHeight/2 + 2
+	face := s.valueLabel.font
+	metrics := face.Metrics()
+
+	for _, value := range s.getTickValues() {
+		p := s.getTrackPosition(value)
 
-	// Filled track (from left to thumb)
-	filledWidth := s.getThumbPosition() - s.getTrackX()
-	if int(filledWidth) > 0 {
-		filledTrackImg := GetCache().ImageWithColor(int(filledWidth), int(s.trackHeight), s.colors.TrackFilled)
 		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(s.getTrackX(), trackY)
-		screen.DrawImage(filledTrackImg, op)
+		var tick *ebiten.Image
+		if s.orientation == OrientationVertical {
+			tick = GetCache().ImageWithColor(int(tickLength), 1, tickColor)
+			op.GeoM.Translate(tickStart, p)
+		} else {
+			tick = GetCache().ImageWithColor(1, int(tickLength), tickColor)
+			op.GeoM.Translate(p, tickStart)
+		}
+		screen.DrawImage(tick, op)
+
+		if !s.showTickLabels {
+			continue
+		}
+
+		label := s.formatTick(value)
+		if s.orientation == OrientationVertical {
+			x := tickStart + tickLength + 3
+			y := p + float64(metrics.Ascent.Ceil())/2
+			text.Draw(screen, label, face, int(x), int(y), labelColor)
+		} else {
+			width := float64(font.MeasureString(face, label).Ceil())
+			x := p - width/2
+			y := tickStart + tickLength + 2 + float64(metrics.Ascent.Ceil())
+			text.Draw(screen, label, face, int(x), int(y), labelColor)
+		}
 	}
 }
 
-func (s *Slider) drawThumb(screen *ebiten.Image) {
+func (s *Slider) drawThumb(screen *ebiten.Image, thumb sliderThumb) {
 	// Determine thumb color based on state
 	var thumbColor color.Color
-	if s.isDragging {
+	if s.isDragging && s.activeThumb == thumb {
 		thumbColor = s.colors.ThumbDragged
 	} else if s.isHovered {
 		thumbColor = s.colors.ThumbHovered
@@ -328,32 +540,37 @@ func (s *Slider) drawThumb(screen *ebiten.Image) {
 	}
 
 	// Create thumb image
-	thumbImg := GetCache().ImageWithColor(int(s.thumbWidth), int(s.thumbHeight), thumbColor)
+	x, y, w, h := s.getThumbBounds(thumb)
+	thumbImg := GetCache().ImageWithColor(int(w), int(h), thumbColor)
 
 	// Draw thumb
-	pos := s.GetAbsolutePosition()
-	size := s.GetSize()
-	thumbX := s.getThumbPosition() - s.thumbWidth/2
-	thumbY := pos.Y + (size.Height-s.thumbHeight)/2
-
 	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(thumbX, thumbY)
+	op.GeoM.Translate(x, y)
 	screen.DrawImage(thumbImg, op)
 }
 
-// drawValueLabel draws the value label on the right side of the slider
+// drawValueLabel draws the value label on the right side of a horizontal slider,
+// or below a vertical one
 func (s *Slider) drawValueLabel(screen *ebiten.Image) {
 	pos := s.GetAbsolutePosition()
 	size := s.GetSize()
+	valueText := s.getValueText()
 
 	// Draw value text directly instead of using a Label component
-	// Position it on the right side of the slider
-	valueX := pos.X + size.Width - 45
-	valueY := pos.Y + size.Height/2 + 5 // Center vertically
+	var valueX, valueY float64
+	if s.orientation == OrientationVertical {
+		width := float64(font.MeasureString(s.valueLabel.font, valueText).Ceil())
+		valueX = pos.X + (size.Width-width)/2
+		valueY = pos.Y + size.Height + float64(s.valueLabel.font.Metrics().Ascent.Ceil()) + 2
+	} else {
+		// Position it on the right side of the slider
+		valueX = pos.X + size.Width - 45
+		valueY = pos.Y + size.Height/2 + 5 // Center vertically
+	}
 
 	text.Draw(
 		screen,
-		s.getValueText(),
+		valueText,
 		s.valueLabel.font,
 		int(valueX),
 		int(valueY),
@@ -361,95 +578,233 @@ func (s *Slider) drawValueLabel(screen *ebiten.Image) {
 	)
 }
 
-func (s *Slider) getTrackX() float64 {
+// getTrackStart returns where the track begins along the slider's axis
+func (s *Slider) getTrackStart() float64 {
 	pos := s.GetAbsolutePosition()
+	if s.orientation == OrientationVertical {
+		return pos.Y + s.thumbWidth/2
+	}
 	return pos.X + s.thumbWidth/2
 }
 
-func (s *Slider) getTrackWidth() float64 {
+// getTrackLength returns the length of the track along the slider's axis
+func (s *Slider) getTrackLength() float64 {
 	size := s.GetSize()
-	// We'll handle the value label separately, so no need to adjust the track width
+	// We'll handle the value label separately, so no need to adjust the track length
+	if s.orientation == OrientationVertical {
+		return size.Height - s.thumbWidth
+	}
 	return size.Width - s.thumbWidth
 }
 
-func (s *Slider) getThumbPosition() float64 {
-	// Calculate the x position of the thumb center based on current value
-	valueRatio := (s.value - s.min) / (s.max - s.min)
-	trackWidth := s.getTrackWidth()
-	return s.getTrackX() + valueRatio*trackWidth
+// getCrossCenter returns the center of the track across the slider's axis
+func (s *Slider) getCrossCenter() float64 {
+	pos := s.GetAbsolutePosition()
+	size := s.GetSize()
+	if s.orientation == OrientationVertical {
+		return pos.X + size.Width/2
+	}
+	return pos.Y + size.Height/2
+}
+
+// getTrackPosition returns the position along the slider's axis for a value
+func (s *Slider) getTrackPosition(value float64) float64 {
+	valueRatio := 0.0
+	if s.max > s.min {
+		valueRatio = (value - s.min) / (s.max - s.min)
+	}
+	if s.orientation == OrientationVertical {
+		// Vertical sliders increase upwards
+		valueRatio = 1 - valueRatio
+	}
+	return s.getTrackStart() + valueRatio*s.getTrackLength()
 }
 
-func (s *Slider) updateValueFromPosition(mouseX float64) {
+func (s *Slider) getThumbPosition(thumb sliderThumb) float64 {
+	// Calculate the position of the thumb center based on its value
+	return s.getTrackPosition(s.getThumbValue(thumb))
+}
+
+// getThumbBounds returns the absolute bounds of a thumb.
+// The thumb width runs along the slider's axis, so it is rotated for vertical sliders.
+func (s *Slider) getThumbBounds(thumb sliderThumb) (x, y, w, h float64) {
+	along := s.getThumbPosition(thumb) - s.thumbWidth/2
+	cross := s.getCrossCenter() - s.thumbHeight/2
+	if s.orientation == OrientationVertical {
+		return cross, along, s.thumbHeight, s.thumbWidth
+	}
+	return along, cross, s.thumbWidth, s.thumbHeight
+}
+
+func (s *Slider) updateValueFromPosition(mouseX, mouseY float64) {
 	// Convert mouse position to slider value
-	trackX := s.getTrackX()
-	trackWidth := s.getTrackWidth()
+	s.setThumbValue(s.activeThumb, s.getValueAtPosition(mouseX, mouseY))
+}
+
+// getValueAtPosition converts a point to a snapped slider value
+func (s *Slider) getValueAtPosition(x, y float64) float64 {
+	coord := x
+	if s.orientation == OrientationVertical {
+		coord = y
+	}
 
 	// Calculate relative position (0.0 to 1.0)
-	relativePos := clamp((mouseX-trackX)/trackWidth, 0, 1)
+	relativePos := 0.0
+	if trackLength := s.getTrackLength(); trackLength > 0 {
+		relativePos = clamp((coord-s.getTrackStart())/trackLength, 0, 1)
+	}
+	if s.orientation == OrientationVertical {
+		relativePos = 1 - relativePos
+	}
 
 	// Convert to value range and apply step size
-	newValue := s.min + relativePos*(s.max-s.min)
+	return s.snapValue(s.min + relativePos*(s.max-s.min))
+}
 
-	// Apply step size if set
+// snapValue rounds a value to the nearest tick when snapping to ticks, or otherwise to the step size
+func (s *Slider) snapValue(value float64) float64 {
+	if s.snapToTicks && s.tickInterval > 0 {
+		return s.min + math.Round((value-s.min)/s.tickInterval)*s.tickInterval
+	}
 	if s.stepSize > 0 {
-		newValue = math.Round(newValue/s.stepSize) * s.stepSize
+		return math.Round(value/s.stepSize) * s.stepSize
+	}
+	return value
+}
+
+// getTickValues returns the values at which tick marks are drawn
+func (s *Slider) getTickValues() []float64 {
+	if s.tickInterval <= 0 || s.max <= s.min {
+		return nil
 	}
 
-	// Set the new value (this will call onChange if value changed)
-	s.SetValue(newValue)
+	count := int(math.Floor((s.max-s.min)/s.tickInterval+1e-9)) + 1
+	values := make([]float64, 0, count)
+	for i := 0; i < count; i++ {
+		values = append(values, s.min+float64(i)*s.tickInterval)
+	}
+	return values
 }
 
-func (s *Slider) isPointOverThumb(x, y float64) bool {
-	pos := s.GetAbsolutePosition()
-	size := s.GetSize()
+func (s *Slider) formatTick(value float64) string {
+	if s.tickFormat != nil {
+		return s.tickFormat(value)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// getNearestThumb returns the thumb closest to a point, preferring the one
+// that can move towards the point when both thumbs overlap
+func (s *Slider) getNearestThumb(x, y float64) sliderThumb {
+	coord := x
+	if s.orientation == OrientationVertical {
+		coord = y
+	}
 
-	thumbX := s.getThumbPosition() - s.thumbWidth/2
-	thumbY := pos.Y + (size.Height-s.thumbHeight)/2
+	lowDist := math.Abs(coord - s.getThumbPosition(sliderThumbLow))
+	highDist := math.Abs(coord - s.getThumbPosition(sliderThumbHigh))
+	if lowDist < highDist {
+		return sliderThumbLow
+	}
+	if highDist < lowDist {
+		return sliderThumbHigh
+	}
+	if s.getValueAtPosition(x, y) < s.value {
+		return sliderThumbLow
+	}
+	return sliderThumbHigh
+}
 
-	return x >= thumbX && x <= thumbX+s.thumbWidth &&
-		y >= thumbY && y <= thumbY+s.thumbHeight
+func (s *Slider) isPointOverThumb(x, y float64) bool {
+	thumbs := []sliderThumb{sliderThumbHigh}
+	if s.rangeMode {
+		thumbs = append(thumbs, sliderThumbLow)
+	}
+
+	for _, thumb := range thumbs {
+		thumbX, thumbY, w, h := s.getThumbBounds(thumb)
+		if x >= thumbX && x <= thumbX+w &&
+			y >= thumbY && y <= thumbY+h {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *Slider) isPointOverTrack(x, y float64) bool {
-	pos := s.GetAbsolutePosition()
-	size := s.GetSize()
+	along, cross := x, y
+	if s.orientation == OrientationVertical {
+		along, cross = y, x
+	}
+
+	trackStart := s.getTrackStart()
+	trackCross := s.getCrossCenter() - s.trackHeight/2
 
-	trackX := s.getTrackX()
-	trackY := pos.Y + (size.Height-s.trackHeight)/2
-	trackWidth := s.getTrackWidth()
+	return along >= trackStart && along <= trackStart+s.getTrackLength() &&
+		cross >= trackCross && cross <= trackCross+s.trackHeight
+}
 
-	return x >= trackX && x <= trackX+trackWidth &&
-		y >= trackY && y <= trackY+s.trackHeight
+// getStep returns the amount the keyboard moves a thumb by
+func (s *Slider) getStep() float64 {
+	if s.snapToTicks && s.tickInterval > 0 {
+		return s.tickInterval
+	}
+	return s.stepSize
 }
 
 func (s *Slider) incrementValue() {
-	s.SetValue(s.value + s.stepSize)
+	s.setThumbValue(s.activeThumb, s.getThumbValue(s.activeThumb)+s.getStep())
 }
 
 func (s *Slider) decrementValue() {
-	s.SetValue(s.value - s.stepSize)
+	s.setThumbValue(s.activeThumb, s.getThumbValue(s.activeThumb)-s.getStep())
 }
 
-func (s *Slider) getValueText() string {
+func (s *Slider) formatValue(value float64) string {
 	// Format value text with suffix if provided
 	if s.valueSuffix != "" {
-		return fmt.Sprintf("%.0f%s", s.value, s.valueSuffix)
+		return fmt.Sprintf("%.0f%s", value, s.valueSuffix)
+	}
+	return fmt.Sprintf("%.0f", value)
+}
+
+func (s *Slider) getValueText() string {
+	if s.rangeMode {
+		return s.formatValue(s.lowValue) + " - " + s.formatValue(s.value)
 	}
-	return fmt.Sprintf("%.0f", s.value)
+	return s.formatValue(s.value)
 }
 
-// SetValue sets the slider's value, respecting min/max bounds
+func (s *Slider) getThumbValue(thumb sliderThumb) float64 {
+	if thumb == sliderThumbLow {
+		return s.lowValue
+	}
+	return s.value
+}
+
+// setThumbValue moves one of the thumbs, keeping the low thumb at or below the high thumb
+func (s *Slider) setThumbValue(thumb sliderThumb, value float64) {
+	if thumb == sliderThumbLow && s.rangeMode {
+		s.SetLowValue(value)
+		return
+	}
+	s.SetValue(value)
+}
+
+// SetValue sets the slider's value, respecting min/max bounds.
+// In range mode this sets the upper value of the range.
 func (s *Slider) SetValue(value float64) {
 	// Clamp value to min/max range
-	newValue := clamp(value, s.min, s.max)
+	lower := s.min
+	if s.rangeMode {
+		lower = s.lowValue
+	}
+	newValue := clamp(value, lower, s.max)
 
 	// Always update the value (needed for reset button functionality)
 	s.value = newValue
 
-	// Update the value label if visible
-	if s.showValue {
-		s.valueLabel.SetText(s.getValueText())
-	}
+	s.valueChanged()
 
 	// Call onChange handler
 	if s.onChange != nil {
@@ -457,11 +812,54 @@ func (s *Slider) SetValue(value float64) {
 	}
 }
 
-// GetValue returns the current slider value
+// GetValue returns the current slider value.
+// In range mode this is the upper value of the range.
 func (s *Slider) GetValue() float64 {
 	return s.value
 }
 
+// SetLowValue sets the lower value of the range in range mode
+func (s *Slider) SetLowValue(value float64) {
+	s.lowValue = clamp(value, s.min, s.value)
+	s.valueChanged()
+}
+
+// GetLowValue returns the lower value of the range in range mode
+func (s *Slider) GetLowValue() float64 {
+	return s.lowValue
+}
+
+// SetRangeValues sets both values of the range in range mode
+func (s *Slider) SetRangeValues(low, high float64) {
+	if low > high {
+		low, high = high, low
+	}
+	s.value = clamp(high, s.min, s.max)
+	s.lowValue = clamp(low, s.min, s.value)
+	s.valueChanged()
+
+	if s.onChange != nil {
+		s.onChange(s.value)
+	}
+}
+
+// GetRangeValues returns the lower and upper values of the range in range mode
+func (s *Slider) GetRangeValues() (float64, float64) {
+	return s.lowValue, s.value
+}
+
+// valueChanged updates the value label and notifies the range handler
+func (s *Slider) valueChanged() {
+	// Update the value label if visible
+	if s.showValue {
+		s.valueLabel.SetText(s.getValueText())
+	}
+
+	if s.rangeMode && s.onRangeChange != nil {
+		s.onRangeChange(s.lowValue, s.value)
+	}
+}
+
 // SetColors sets the color scheme for the slider
 func (s *Slider) SetColors(colors SliderColors) {
 	s.colors = colors
@@ -469,14 +867,6 @@ func (s *Slider) SetColors(colors SliderColors) {
 
 // handleKeyRepeat implements continuous sliding when keys are held down
 func (s *Slider) handleKeyRepeat() {
-	// Arrow keys for slider movement
-	leftKey := ebiten.KeyLeft
-	rightKey := ebiten.KeyRight
-
-	// Check if either key is pressed
-	leftPressed := ebiten.IsKeyPressed(leftKey)
-	rightPressed := ebiten.IsKeyPressed(rightKey)
-
 	// Handle key release
 	if s.repeatKey != -1 &&
 		!ebiten.IsKeyPressed(s.repeatKey) {
@@ -486,16 +876,14 @@ func (s *Slider) handleKeyRepeat() {
 
 	// Handle new key press
 	if s.repeatKey == -1 {
-		if leftPressed {
-			s.repeatKey = leftKey
-			s.repeatStart = time.Now()
-			s.lastRepeat = time.Now()
-			// Initial input is handled by IsKeyJustPressed in handleInput
-		} else if rightPressed {
-			s.repeatKey = rightKey
-			s.repeatStart = time.Now()
-			s.lastRepeat = time.Now()
-			// Initial input is handled by IsKeyJustPressed in handleInput
+		for _, key := range append(sliderDecrementKeys, sliderIncrementKeys...) {
+			if ebiten.IsKeyPressed(key) {
+				s.repeatKey = key
+				s.repeatStart = time.Now()
+				s.lastRepeat = time.Now()
+				// Initial input is handled by IsKeyJustPressed in handleInput
+				break
+			}
 		}
 		return
 	}
@@ -513,9 +901,10 @@ func (s *Slider) handleKeyRepeat() {
 	}
 
 	// Handle repeated key input
-	if s.repeatKey == leftKey {
+	switch s.repeatKey {
+	case ebiten.KeyLeft, ebiten.KeyDown:
 		s.decrementValue()
-	} else if s.repeatKey == rightKey {
+	case ebiten.KeyRight, ebiten.KeyUp:
 		s.incrementValue()
 	}
 
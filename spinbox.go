@@ -0,0 +1,558 @@
+package ebui
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+var _ FocusableComponent = &SpinBox{}
+var _ Scrollable = &SpinBox{}
+
+// SpinBoxColors represents the color scheme for the spin box buttons
+type SpinBoxColors struct {
+	Button        color.Color
+	ButtonHovered color.Color
+	ButtonPressed color.Color
+	Arrow         color.Color
+}
+
+// DefaultSpinBoxColors returns a default color scheme for spin box buttons
+func DefaultSpinBoxColors() SpinBoxColors {
+	return SpinBoxColors{
+		Button:        color.RGBA{220, 220, 220, 255},
+		ButtonHovered: color.RGBA{200, 200, 200, 255},
+		ButtonPressed: color.RGBA{170, 170, 170, 255},
+		Arrow:         color.RGBA{60, 60, 60, 255},
+	}
+}
+
+// spinButton identifies one of the spin box step buttons
+type spinButton int
+
+const (
+	spinButtonNone spinButton = iota
+	spinButtonUp
+	spinButtonDown
+)
+
+// SpinBox is a numeric text field with increment and decrement buttons.
+// It accepts the TextInput options for colors, padding and placeholder text.
+type SpinBox struct {
+	*TextInput
+	min           float64
+	max           float64
+	value         float64
+	stepSize      float64
+	precision     int
+	buttonWidth   float64
+	colors        SpinBoxColors
+	onValueChange func(value float64)
+	updatingText  bool
+
+	hoveredButton spinButton
+	pressedButton spinButton
+
+	// Step repeat tracking for held keys and buttons
+	stepKey   ebiten.Key
+	stepStart time.Time
+	lastStep  time.Time
+
+	// Wheel tracking for accelerated scrolling
+	lastWheel   time.Time
+	wheelStreak int
+}
+
+// WithPrecision sets the number of decimal places shown by the spin box.
+// A precision of 0 makes the spin box accept integers only.
+func WithPrecision(decimals int) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*SpinBox); ok {
+			s.precision = max(decimals, 0)
+		}
+	}
+}
+
+// WithSpinButtonWidth sets the width of the increment and decrement buttons
+func WithSpinButtonWidth(width float64) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*SpinBox); ok {
+			s.buttonWidth = width
+		}
+	}
+}
+
+// WithSpinBoxColors sets the colors for the spin box buttons
+func WithSpinBoxColors(colors SpinBoxColors) ComponentOpt {
+	return func(c Component) {
+		if s, ok := c.(*SpinBox); ok {
+			s.colors = colors
+		}
+	}
+}
+
+// NewSpinBox creates a new numeric spin box.
+// Use WithMinValue, WithMaxValue, WithStepSize, WithValue and WithOnChangeHandler to configure the value.
+func NewSpinBox(opts ...ComponentOpt) *SpinBox {
+	s := &SpinBox{
+		TextInput:     NewTextInput(opts...),
+		min:           0,
+		max:           100,
+		value:         0,
+		stepSize:      1,
+		precision:     0,
+		buttonWidth:   18,
+		colors:        DefaultSpinBoxColors(),
+		onValueChange: func(value float64) {},
+		stepKey:       -1,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// Reserve room on the right for the step buttons
+	padding := s.GetPadding()
+	padding.Right += s.buttonWidth
+	s.SetPadding(padding)
+
+	// The text input is driven by the spin box, so its handlers are replaced
+	s.filter = s.acceptsNumericRune
+	s.validator = s.validateText
+	s.onChange = s.handleTextChange
+	s.onSubmit = func(string) { s.commitText() }
+
+	s.value = s.normalize(s.value)
+	s.updateText()
+	s.ClearHistory()
+
+	s.registerEventListeners()
+	return s
+}
+
+func (s *SpinBox) registerEventListeners() {
+	s.AddEventListener(MouseMove, func(e *Event) {
+		s.hoveredButton = s.getButtonAt(e.MouseX, e.MouseY)
+	})
+
+	s.AddEventListener(MouseLeave, func(e *Event) {
+		s.hoveredButton = spinButtonNone
+	})
+
+	// The pointer is captured while a step button is held, so it keeps repeating
+	// only while the pointer is over it and stops wherever the button is released
+	s.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		button := s.getButtonAt(e.MouseX, e.MouseY)
+		if button == spinButtonNone {
+			return
+		}
+		s.pressedButton = button
+		s.stepStart = time.Now()
+		s.lastStep = time.Now()
+		s.stepButton(button, 1)
+		e.SetPointerCapture(s)
+	})
+
+	s.AddEventListener(MouseUp, func(e *Event) {
+		s.pressedButton = spinButtonNone
+	})
+
+	s.AddEventListener(LostPointerCapture, func(e *Event) {
+		s.pressedButton = spinButtonNone
+	})
+
+	s.AddEventListener(Wheel, func(e *Event) {
+		if !s.isFocused {
+			s.passWheelToParent(e)
+			return
+		}
+		s.handleWheel(e.WheelDeltaY)
+	})
+
+	s.AddEventListener(Blur, func(e *Event) {
+		s.commitText()
+		s.stepKey = -1
+	})
+}
+
+func (s *SpinBox) Update() error {
+	s.handleButtonRepeat()
+	if s.isFocused {
+		s.handleStepKeys()
+	}
+	return s.TextInput.Update()
+}
+
+// handleButtonRepeat keeps stepping while a step button is held down
+func (s *SpinBox) handleButtonRepeat() {
+	if s.pressedButton == spinButtonNone {
+		return
+	}
+
+	if s.shouldRepeatStep() {
+		if s.hoveredButton == s.pressedButton {
+			s.stepButton(s.pressedButton, s.getAcceleration())
+		}
+		s.lastStep = time.Now()
+	}
+}
+
+// handleStepKeys steps the value with the arrow and page keys, accelerating while they are held
+func (s *SpinBox) handleStepKeys() {
+	keys := []ebiten.Key{
+		ebiten.KeyUp,
+		ebiten.KeyDown,
+		ebiten.KeyPageUp,
+		ebiten.KeyPageDown,
+	}
+
+	for _, key := range keys {
+		if inpututil.IsKeyJustPressed(key) {
+			s.stepKey = key
+			s.stepStart = time.Now()
+			s.lastStep = time.Now()
+			s.stepForKey(key, 1)
+			return
+		}
+	}
+
+	if s.stepKey == -1 {
+		return
+	}
+	if !ebiten.IsKeyPressed(s.stepKey) {
+		s.stepKey = -1
+		return
+	}
+
+	if s.shouldRepeatStep() {
+		s.stepForKey(s.stepKey, s.getAcceleration())
+		s.lastStep = time.Now()
+	}
+}
+
+// handleWheel steps the value when the wheel is scrolled over the focused spin box.
+// Quick successive wheel ticks step further.
+func (s *SpinBox) handleWheel(wheelY float64) {
+	if wheelY == 0 {
+		return
+	}
+
+	if time.Since(s.lastWheel) < 100*time.Millisecond {
+		s.wheelStreak++
+	} else {
+		s.wheelStreak = 0
+	}
+	s.lastWheel = time.Now()
+
+	factor := math.Min(1+float64(s.wheelStreak/5), 10)
+	if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		factor *= 10
+	}
+	s.commitText()
+	s.SetValue(s.value + math.Copysign(s.stepSize*factor, wheelY))
+}
+
+// passWheelToParent scrolls the nearest scrollable component around an unfocused spin box,
+// which would otherwise swallow the wheel
+func (s *SpinBox) passWheelToParent(e *Event) {
+	for i := len(e.Path) - 2; i >= 0; i-- {
+		if scrollable, ok := e.Path[i].(Scrollable); ok {
+			parentEvent := *e
+			parentEvent.Target = scrollable
+			parentEvent.Path = e.Path[:i+1]
+			parentEvent.Phase = PhaseTarget
+			scrollable.HandleEvent(&parentEvent)
+			return
+		}
+	}
+}
+
+func (s *SpinBox) shouldRepeatStep() bool {
+	initialDelay := 500 * time.Millisecond
+	repeatDelay := 50 * time.Millisecond
+
+	return time.Since(s.stepStart) >= initialDelay &&
+		time.Since(s.lastStep) >= repeatDelay
+}
+
+// getAcceleration returns the step multiplier for a key or button that has been held down
+func (s *SpinBox) getAcceleration() float64 {
+	held := time.Since(s.stepStart)
+	switch {
+	case held > 3*time.Second:
+		return 100
+	case held > 2*time.Second:
+		return 10
+	default:
+		return 1
+	}
+}
+
+func (s *SpinBox) stepButton(button spinButton, factor float64) {
+	s.commitText()
+	if button == spinButtonUp {
+		s.SetValue(s.value + s.stepSize*factor)
+	} else {
+		s.SetValue(s.value - s.stepSize*factor)
+	}
+}
+
+func (s *SpinBox) stepForKey(key ebiten.Key, factor float64) {
+	s.commitText()
+	switch key {
+	case ebiten.KeyUp:
+		s.SetValue(s.value + s.stepSize*factor)
+	case ebiten.KeyDown:
+		s.SetValue(s.value - s.stepSize*factor)
+	case ebiten.KeyPageUp:
+		s.SetValue(s.value + s.stepSize*10*factor)
+	case ebiten.KeyPageDown:
+		s.SetValue(s.value - s.stepSize*10*factor)
+	}
+}
+
+func (s *SpinBox) Draw(screen *ebiten.Image) {
+	if s.IsHidden() {
+		return
+	}
+
+	s.TextInput.Draw(screen)
+	s.drawButton(screen, spinButtonUp)
+	s.drawButton(screen, spinButtonDown)
+}
+
+func (s *SpinBox) drawButton(screen *ebiten.Image, button spinButton) {
+	x, y, w, h := s.getButtonBounds(button)
+	if w <= 0 || h <= 0 {
+		return
+	}
+
+	bgColor := s.colors.Button
+	if s.pressedButton == button {
+		bgColor = s.colors.ButtonPressed
+	} else if s.hoveredButton == button {
+		bgColor = s.colors.ButtonHovered
+	}
+
+	bg := GetCache().ImageWithColor(int(w), int(h), bgColor)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x, y)
+	screen.DrawImage(bg, op)
+
+	dir := DirectionUp
+	if button == spinButtonDown {
+		dir = DirectionDown
+	}
+	drawArrow(screen, x+w/2, y+h/2, math.Min(8, h-2), dir, s.colors.Arrow)
+}
+
+// getButtonBounds returns the absolute bounds of a step button.
+// The buttons sit directly to the right of the text background, stacked vertically.
+func (s *SpinBox) getButtonBounds(button spinButton) (x, y, w, h float64) {
+	pos := s.GetAbsolutePosition()
+	size := s.GetSize()
+	padding := s.GetPadding()
+
+	x = pos.X + size.Width - padding.Right
+	w = s.buttonWidth
+	h = (size.Height - padding.Top - padding.Bottom) / 2
+	y = pos.Y + padding.Top
+	if button == spinButtonDown {
+		y += h
+	}
+	return x, y, w, h
+}
+
+func (s *SpinBox) getButtonAt(mouseX, mouseY float64) spinButton {
+	for _, button := range []spinButton{spinButtonUp, spinButtonDown} {
+		x, y, w, h := s.getButtonBounds(button)
+		if mouseX >= x && mouseX < x+w && mouseY >= y && mouseY < y+h {
+			return button
+		}
+	}
+	return spinButtonNone
+}
+
+// acceptsNumericRune is the input filter for the text field
+func (s *SpinBox) acceptsNumericRune(r rune) bool {
+	if DigitsOnly(r) {
+		return true
+	}
+	if r == '-' {
+		return s.min < 0
+	}
+	if r == '.' {
+		return s.precision > 0
+	}
+	return false
+}
+
+// parseText parses the text field as a number
+func (s *SpinBox) parseText(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0, errors.New("enter a number")
+	}
+
+	if s.precision == 0 {
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return 0, errors.New("enter a whole number")
+		}
+		return float64(v), nil
+	}
+
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, errors.New("enter a number")
+	}
+	return v, nil
+}
+
+// validateText is the validator for the text field
+func (s *SpinBox) validateText(text string) error {
+	v, err := s.parseText(text)
+	if err != nil {
+		return err
+	}
+	if v < s.min || v > s.max {
+		return fmt.Errorf("must be between %s and %s", s.formatValue(s.min), s.formatValue(s.max))
+	}
+	return nil
+}
+
+// handleTextChange applies valid values while the user is typing
+func (s *SpinBox) handleTextChange(text string) {
+	if s.updatingText {
+		return
+	}
+	if s.validateText(text) != nil {
+		return
+	}
+	v, _ := s.parseText(text)
+	s.setValue(v, false)
+}
+
+// commitText applies the typed text, clamping it into range.
+// Text that isn't a number is rejected and replaced with the current value.
+func (s *SpinBox) commitText() {
+	if v, err := s.parseText(string(s.text)); err == nil {
+		s.setValue(v, true)
+		return
+	}
+	s.updateText()
+}
+
+// normalize clamps a value into range and rounds it to the configured precision
+func (s *SpinBox) normalize(value float64) float64 {
+	value = clamp(value, s.min, s.max)
+	scale := math.Pow(10, float64(s.precision))
+	return math.Round(value*scale) / scale
+}
+
+func (s *SpinBox) formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', s.precision, 64)
+}
+
+// updateText replaces the text with the formatted current value
+func (s *SpinBox) updateText() {
+	text := s.formatValue(s.value)
+	if text == string(s.text) {
+		return
+	}
+	s.updatingText = true
+	s.TextInput.SetText(text)
+	s.updatingText = false
+}
+
+func (s *SpinBox) setValue(value float64, updateText bool) {
+	value = s.normalize(value)
+	changed := value != s.value
+	s.value = value
+
+	if updateText {
+		s.updateText()
+	}
+	if changed && s.onValueChange != nil {
+		s.onValueChange(s.value)
+	}
+}
+
+// SetValue sets the spin box value, clamped into range and rounded to the precision
+func (s *SpinBox) SetValue(value float64) {
+	s.setValue(value, true)
+}
+
+// GetValue returns the current spin box value
+func (s *SpinBox) GetValue() float64 {
+	return s.value
+}
+
+// SetRange sets the minimum and maximum values, clamping the current value into the new range
+func (s *SpinBox) SetRange(min, max float64) {
+	s.min = min
+	s.max = max
+	s.SetValue(s.value)
+}
+
+// GetRange returns the minimum and maximum values
+func (s *SpinBox) GetRange() (float64, float64) {
+	return s.min, s.max
+}
+
+// SetStepSize sets the amount the value changes by for each step
+func (s *SpinBox) SetStepSize(step float64) {
+	s.stepSize = step
+}
+
+// Increment increases the value by one step
+func (s *SpinBox) Increment() {
+	s.commitText()
+	s.SetValue(s.value + s.stepSize)
+}
+
+// Decrement decreases the value by one step
+func (s *SpinBox) Decrement() {
+	s.commitText()
+	s.SetValue(s.value - s.stepSize)
+}
+
+// SetColors sets the color scheme for the spin box buttons
+func (s *SpinBox) SetColors(colors SpinBoxColors) {
+	s.colors = colors
+}
+
+// The spin box implements Scrollable so that it receives the wheel instead of the scrollable
+// components around it. It has no scroll bar, so the scrolling methods do nothing.
+
+func (s *SpinBox) IsWithinBounds(x, y float64) bool {
+	return s.Contains(x, y)
+}
+
+func (s *SpinBox) GetScrollOffset() Position {
+	return Position{}
+}
+
+func (s *SpinBox) SetScrollOffset(offset Position) {}
+
+func (s *SpinBox) HideScrollBar() {}
+
+func (s *SpinBox) ShowScrollBar() {}
+
+func (s *SpinBox) IsScrollBarHidden() bool {
+	return true
+}
+
+func (s *SpinBox) ScrollToTop() {}
+
+func (s *SpinBox) ScrollToBottom() {}
@@ -0,0 +1,62 @@
+package ebui
+
+import "testing"
+
+func TestSpinBoxParseText(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		text      string
+		want      float64
+		wantErr   bool
+	}{
+		{name: "integer", text: "42", want: 42},
+		{name: "surrounding spaces", text: " -7 ", want: -7},
+		{name: "empty", text: "", wantErr: true},
+		{name: "not a number", text: "abc", wantErr: true},
+		{name: "decimal without precision", text: "3.5", wantErr: true},
+		{name: "decimal", precision: 2, text: "3.25", want: 3.25},
+		{name: "exponent", precision: 2, text: "1e3", want: 1000},
+		{name: "NaN", precision: 2, text: "NaN", wantErr: true},
+		{name: "infinity", precision: 2, text: "Inf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpinBox(WithSize(100, 30), WithPrecision(tt.precision))
+			got, err := s.parseText(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseText(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("parseText(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpinBoxNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		min, max  float64
+		precision int
+		value     float64
+		want      float64
+	}{
+		{name: "below min", min: 0, max: 100, value: -5, want: 0},
+		{name: "above max", min: 0, max: 100, value: 150, want: 100},
+		{name: "rounded to integer", min: 0, max: 100, value: 2.5, want: 3},
+		{name: "rounded to precision", min: 0, max: 100, precision: 2, value: 1.234, want: 1.23},
+		{name: "negative rounded away from zero", min: -1, max: 1, precision: 1, value: -0.26, want: -0.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpinBox(WithSize(100, 30), WithPrecision(tt.precision))
+			s.min, s.max = tt.min, tt.max
+			if got := s.normalize(tt.value); got != tt.want {
+				t.Errorf("normalize(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package ebui
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var _ InteractiveComponent = &SplitPane{}
+
+// SplitPaneColors represents the color scheme for a split pane divider
+type SplitPaneColors struct {
+	Divider        color.Color
+	DividerHovered color.Color
+	DividerDragged color.Color
+	Grip           color.Color
+}
+
+// DefaultSplitPaneColors returns a default color scheme for split panes
+func DefaultSplitPaneColors() SplitPaneColors {
+	return SplitPaneColors{
+		Divider:        color.RGBA{200, 200, 200, 255},
+		DividerHovered: color.RGBA{180, 180, 180, 255},
+		DividerDragged: color.RGBA{100, 149, 237, 255}, // Cornflower blue
+		Grip:           color.RGBA{120, 120, 120, 255},
+	}
+}
+
+// splitCollapse records which pane, if any, is collapsed
+type splitCollapse int
+
+const (
+	splitExpanded splitCollapse = iota
+	splitFirstCollapsed
+	splitSecondCollapsed
+)
+
+const doubleClickInterval = 400 * time.Millisecond
+
+// SplitPane is a container that shows two panes separated by a draggable divider.
+// Horizontal split panes place the panes left and right, vertical ones top and bottom.
+// Double-clicking the divider collapses the smaller pane, and double-clicking again restores it.
+type SplitPane struct {
+	*BaseInteractive
+	*BaseContainer
+	first         Component
+	second        Component
+	orientation   Orientation
+	ratio         float64 // Fraction of the available length given to the first pane
+	dividerSize   float64
+	minFirst      float64
+	minSecond     float64
+	collapsed     splitCollapse
+	colors        SplitPaneColors
+	onRatioChange func(ratio float64)
+	isDragging    bool
+	dragOffset    float64 // Distance from the divider start to the mouse when dragging began
+	isHovered     bool
+	lastClick     time.Time
+}
+
+// WithSplitRatio sets the initial fraction of the space given to the first pane
+func WithSplitRatio(ratio float64) ComponentOpt {
+	return func(c Component) {
+		if sp, ok := c.(*SplitPane); ok {
+			sp.ratio = clamp(ratio, 0, 1)
+		}
+	}
+}
+
+// WithMinPaneSizes sets the minimum length of the first and second panes along the split
+func WithMinPaneSizes(first, second float64) ComponentOpt {
+	return func(c Component) {
+		if sp, ok := c.(*SplitPane); ok {
+			sp.minFirst = first
+			sp.minSecond = second
+		}
+	}
+}
+
+// WithDividerSize sets the thickness of the divider
+func WithDividerSize(size float64) ComponentOpt {
+	return func(c Component) {
+		if sp, ok := c.(*SplitPane); ok {
+			sp.dividerSize = size
+		}
+	}
+}
+
+// WithSplitPaneColors sets the colors for the split pane divider
+func WithSplitPaneColors(colors SplitPaneColors) ComponentOpt {
+	return func(c Component) {
+		if sp, ok := c.(*SplitPane); ok {
+			sp.colors = colors
+		}
+	}
+}
+
+// WithRatioChangeHandler sets a function called when the split ratio changes,
+// which can be used to persist the layout
+func WithRatioChangeHandler(handler func(ratio float64)) ComponentOpt {
+	return func(c Component) {
+		if sp, ok := c.(*SplitPane); ok {
+			sp.onRatioChange = handler
+		}
+	}
+}
+
+// NewSplitPane creates a new split pane containing the two given panes
+func NewSplitPane(first, second Component, opts ...ComponentOpt) *SplitPane {
+	sp := &SplitPane{
+		BaseInteractive: NewBaseInteractive(),
+		BaseContainer:   NewBaseContainer(opts...),
+		first:           first,
+		second:          second,
+		orientation:     OrientationHorizontal,
+		ratio:           0.5,
+		dividerSize:     6,
+		colors:          DefaultSplitPaneColors(),
+		onRatioChange:   func(ratio float64) {},
+	}
+
+	for _, opt := range opts {
+		opt(sp)
+	}
+
+	sp.BaseContainer.AddChild(first)
+	sp.BaseContainer.AddChild(second)
+	sp.layout()
+
+	sp.registerEventListeners()
+	return sp
+}
+
+func (sp *SplitPane) registerEventListeners() {
+	sp.AddEventListener(MouseEnter, func(e *Event) {
+		sp.isHovered = e.Target == sp
+	})
+
+	sp.AddEventListener(MouseMove, func(e *Event) {
+		sp.isHovered = e.Target == sp && sp.isOverDivider(e.MouseX, e.MouseY)
+	})
+
+	sp.AddEventListener(MouseLeave, func(e *Event) {
+		if e.Target == sp {
+			sp.isHovered = false
+		}
+	})
+
+	sp.AddEventListener(MouseDown, func(e *Event) {
+		if e.Target != sp || e.MouseButton != ebiten.MouseButtonLeft || !sp.isOverDivider(e.MouseX, e.MouseY) {
+			return
+		}
+
+		// Capture the pointer so the resize cursor stays while dragging the divider
+		e.SetPointerCapture(sp)
+
+		now := time.Now()
+		if now.Sub(sp.lastClick) <= doubleClickInterval {
+			sp.lastClick = time.Time{}
+			sp.ToggleCollapse()
+			return
+		}
+		sp.lastClick = now
+	})
+
+	sp.AddEventListener(DragStart, func(e *Event) {
+		if e.Target != sp || e.MouseButton != ebiten.MouseButtonLeft || !sp.isOverDivider(e.MouseX, e.MouseY) {
+			return
+		}
+		sp.isDragging = true
+		sp.dragOffset = sp.getMainAxis(e.MouseX, e.MouseY) - sp.getDividerStart()
+	})
+
+	sp.AddEventListener(Drag, func(e *Event) {
+		if !sp.isDragging {
+			return
+		}
+
+		// Dragging a collapsed divider expands the pane again
+		sp.setCollapsed(splitExpanded)
+		pos := sp.GetAbsolutePosition()
+		start := sp.getMainAxis(pos.X, pos.Y)
+		firstLength := sp.getMainAxis(e.MouseX, e.MouseY) - sp.dragOffset - start
+		sp.setFirstLength(firstLength)
+	})
+
+	sp.AddEventListener(DragEnd, func(e *Event) {
+		sp.isDragging = false
+	})
+}
+
+func (sp *SplitPane) Update() error {
+	// Keep the panes sized to the split pane, which may have been resized
+	sp.layout()
+	return sp.BaseContainer.Update()
+}
+
+// getMainAxis returns the coordinate along the split direction
+func (sp *SplitPane) getMainAxis(x, y float64) float64 {
+	if sp.orientation == OrientationVertical {
+		return y
+	}
+	return x
+}
+
+// getLength returns the size of the split pane along the split direction
+func (sp *SplitPane) getLength() float64 {
+	size := sp.GetSize()
+	return sp.getMainAxis(size.Width, size.Height)
+}
+
+// getAvailableLength returns the length shared by both panes
+func (sp *SplitPane) getAvailableLength() float64 {
+	return math.Max(0, sp.getLength()-sp.dividerSize)
+}
+
+// getFirstLength returns the length of the first pane, respecting min sizes and collapsing
+func (sp *SplitPane) getFirstLength() float64 {
+	available := sp.getAvailableLength()
+	switch sp.collapsed {
+	case splitFirstCollapsed:
+		return 0
+	case splitSecondCollapsed:
+		return available
+	}
+
+	length := sp.ratio * available
+	length = math.Min(length, available-sp.minSecond)
+	length = math.Max(length, sp.minFirst)
+	return clamp(length, 0, available)
+}
+
+// setFirstLength sets the ratio from the length of the first pane
+func (sp *SplitPane) setFirstLength(length float64) {
+	available := sp.getAvailableLength()
+	if available <= 0 {
+		return
+	}
+	length = math.Min(length, available-sp.minSecond)
+	length = math.Max(length, sp.minFirst)
+	sp.SetRatio(length / available)
+}
+
+// getDividerStart returns the absolute position of the divider along the split direction
+func (sp *SplitPane) getDividerStart() float64 {
+	pos := sp.GetAbsolutePosition()
+	return sp.getMainAxis(pos.X, pos.Y) + sp.getFirstLength()
+}
+
+// GetCursorAt returns a resize cursor over the divider
+func (sp *SplitPane) GetCursorAt(x, y float64) ebiten.CursorShapeType {
+	if !sp.isDragging && !sp.isOverDivider(x, y) {
+		return sp.GetCursor()
+	}
+	if sp.orientation == OrientationVertical {
+		return ebiten.CursorShapeNSResize
+	}
+	return ebiten.CursorShapeEWResize
+}
+
+func (sp *SplitPane) isOverDivider(x, y float64) bool {
+	if !sp.Contains(x, y) {
+		return false
+	}
+	start := sp.getDividerStart()
+	along := sp.getMainAxis(x, y)
+	return along >= start && along < start+sp.dividerSize
+}
+
+// layout positions and sizes the panes on either side of the divider
+func (sp *SplitPane) layout() {
+	size := sp.GetSize()
+	firstLength := sp.getFirstLength()
+	secondLength := sp.getAvailableLength() - firstLength
+	secondStart := firstLength + sp.dividerSize
+
+	if sp.orientation == OrientationVertical {
+		sp.first.SetPosition(Position{X: 0, Y: 0, Relative: true})
+		sp.first.SetSize(Size{Width: size.Width, Height: firstLength})
+		sp.second.SetPosition(Position{X: 0, Y: secondStart, Relative: true})
+		sp.second.SetSize(Size{Width: size.Width, Height: secondLength})
+	} else {
+		sp.first.SetPosition(Position{X: 0, Y: 0, Relative: true})
+		sp.first.SetSize(Size{Width: firstLength, Height: size.Height})
+		sp.second.SetPosition(Position{X: secondStart, Y: 0, Relative: true})
+		sp.second.SetSize(Size{Width: secondLength, Height: size.Height})
+	}
+}
+
+// setCollapsed changes which pane is collapsed. Collapsed panes are hidden so they are not drawn, hit or focused,
+// and a component focused inside a collapsing pane loses focus.
+// Visibility is only changed when the pane collapses or expands, so panes hidden or disabled by the application stay that way.
+func (sp *SplitPane) setCollapsed(collapsed splitCollapse) {
+	if sp.collapsed == collapsed {
+		return
+	}
+	previous := sp.collapsed
+	sp.collapsed = collapsed
+
+	if pane := sp.getCollapsedPane(previous); pane != nil {
+		pane.Show()
+		pane.Enable()
+	}
+	if pane := sp.getCollapsedPane(collapsed); pane != nil {
+		pane.Hide()
+		pane.Disable()
+	}
+}
+
+// getCollapsedPane returns the pane that is collapsed in the given state, or nil if both are expanded
+func (sp *SplitPane) getCollapsedPane(collapsed splitCollapse) Component {
+	switch collapsed {
+	case splitFirstCollapsed:
+		return sp.first
+	case splitSecondCollapsed:
+		return sp.second
+	}
+	return nil
+}
+
+func (sp *SplitPane) Draw(screen *ebiten.Image) {
+	if sp.IsHidden() {
+		return
+	}
+
+	sp.BaseContainer.Draw(screen)
+	sp.drawDivider(screen)
+}
+
+func (sp *SplitPane) drawDivider(screen *ebiten.Image) {
+	pos := sp.GetAbsolutePosition()
+	size := sp.GetSize()
+	start := sp.getFirstLength()
+
+	dividerColor := sp.colors.Divider
+	if sp.isDragging {
+		dividerColor = sp.colors.DividerDragged
+	} else if sp.isHovered {
+		dividerColor = sp.colors.DividerHovered
+	}
+
+	x, y := pos.X+start, pos.Y
+	w, h := sp.dividerSize, size.Height
+	if sp.orientation == OrientationVertical {
+		x, y = pos.X, pos.Y+start
+		w, h = size.Width, sp.dividerSize
+	}
+	if w < 1 || h < 1 {
+		return
+	}
+
+	divider := GetCache().ImageWithColor(int(w), int(h), dividerColor)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x, y)
+	screen.DrawImage(divider, op)
+
+	// Draw three grip dots in the middle of the divider
+	dot := GetCache().ImageWithColor(2, 2, sp.colors.Grip)
+	for i := -1; i <= 1; i++ {
+		op := &ebiten.DrawImageOptions{}
+		if sp.orientation == OrientationVertical {
+			op.GeoM.Translate(x+w/2-1+float64(i*5), y+h/2-1)
+		} else {
+			op.GeoM.Translate(x+w/2-1, y+h/2-1+float64(i*5))
+		}
+		screen.DrawImage(dot, op)
+	}
+}
+
+// SetRatio sets the fraction of the available space given to the first pane
+func (sp *SplitPane) SetRatio(ratio float64) {
+	ratio = clamp(ratio, 0, 1)
+	if ratio == sp.ratio {
+		return
+	}
+	sp.ratio = ratio
+	sp.layout()
+	sp.onRatioChange(ratio)
+}
+
+// GetRatio returns the fraction of the available space given to the first pane.
+// The ratio is kept while a pane is collapsed, so it can be saved and restored.
+func (sp *SplitPane) GetRatio() float64 {
+	return sp.ratio
+}
+
+// ToggleCollapse collapses the smaller pane, or restores a collapsed pane
+func (sp *SplitPane) ToggleCollapse() {
+	if sp.collapsed != splitExpanded {
+		sp.setCollapsed(splitExpanded)
+	} else if sp.getFirstLength() <= sp.getAvailableLength()/2 {
+		sp.setCollapsed(splitFirstCollapsed)
+	} else {
+		sp.setCollapsed(splitSecondCollapsed)
+	}
+	sp.layout()
+}
+
+// IsCollapsed returns whether either pane is collapsed
+func (sp *SplitPane) IsCollapsed() bool {
+	return sp.collapsed != splitExpanded
+}
+
+// GetFirst returns the first pane, on the left or top
+func (sp *SplitPane) GetFirst() Component {
+	return sp.first
+}
+
+// GetSecond returns the second pane, on the right or bottom
+func (sp *SplitPane) GetSecond() Component {
+	return sp.second
+}
+
+// SetColors sets the color scheme for the divider
+func (sp *SplitPane) SetColors(colors SplitPaneColors) {
+	sp.colors = colors
+}
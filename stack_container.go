@@ -2,6 +2,7 @@ package ebui
 
 import (
 	"image"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -9,26 +10,88 @@ import (
 // StackContainer manages a stack of views/screens with transitions
 type StackContainer struct {
 	*BaseContainer
-	stack           []Component
-	transitioning   bool
-	transitionPos   float64
-	pushing         bool
-	transitionSpeed float64
+	stack      []Component
+	pushing    bool
+	transition slideTransition
+}
+
+// slideTransition animates two views sliding horizontally, one replacing the other
+type slideTransition struct {
+	from     Component
+	to       Component
+	forward  bool // true slides the new view in from the right, false from the left
+	progress float64
+	speed    float64
+	active   bool
+}
+
+// start begins sliding from one view to another
+func (st *slideTransition) start(from, to Component, forward bool) {
+	st.from = from
+	st.to = to
+	st.forward = forward
+	st.progress = 0
+	st.active = true
+}
+
+// step advances the transition and positions both views relative to originX.
+// It returns true once the transition has finished.
+func (st *slideTransition) step(originX, width float64) bool {
+	if !st.active {
+		return false
+	}
+
+	st.progress = math.Min(st.progress+st.speed, 1)
+	if st.speed <= 0 {
+		st.progress = 1
+	}
+
+	offset := width * st.progress
+	if st.forward {
+		// Move previous view left and new view in from right
+		setPositionX(st.from, originX-offset)
+		setPositionX(st.to, originX+width-offset)
+	} else {
+		// Move previous view right and new view in from left
+		setPositionX(st.from, originX+offset)
+		setPositionX(st.to, originX-width+offset)
+	}
+
+	if st.progress >= 1 {
+		st.active = false
+		return true
+	}
+	return false
+}
+
+func setPositionX(c Component, x float64) {
+	pos := c.GetPosition()
+	pos.X = x
+	c.SetPosition(pos)
 }
 
 func WithTransitionSpeed(speed float64) ComponentOpt {
 	return func(c Component) {
-		if sc, ok := c.(*StackContainer); ok {
-			sc.transitionSpeed = speed
+		switch t := c.(type) {
+		case *StackContainer:
+			t.transition.speed = speed
+		case *TabContainer:
+			t.transition.speed = speed
+		case *ProgressBar:
+			t.speed = speed
+		case *CollapsibleSection:
+			t.speed = speed
 		}
 	}
 }
 
 func NewStackContainer(opts ...ComponentOpt) *StackContainer {
 	sc := &StackContainer{
-		BaseContainer:   NewBaseContainer(opts...),
-		stack:           make([]Component, 0),
-		transitionSpeed: 0.05, // Default transition speed
+		BaseContainer: NewBaseContainer(opts...),
+		stack:         make([]Component, 0),
+		transition: slideTransition{
+			speed: 0.05, // Default transition speed
+		},
 	}
 	for _, opt := range opts {
 		opt(sc)
@@ -38,7 +101,7 @@ func NewStackContainer(opts ...ComponentOpt) *StackContainer {
 
 // Push adds a new view to the stack with a transition
 func (sc *StackContainer) Push(view Container) {
-	if sc.transitioning {
+	if sc.transition.active {
 		return
 	}
 
@@ -56,9 +119,8 @@ func (sc *StackContainer) Push(view Container) {
 	}
 
 	// For subsequent views, do the sliding animation
-	sc.transitioning = true
 	sc.pushing = true
-	sc.transitionPos = 0
+	sc.transition.start(sc.stack[len(sc.stack)-1], view, true)
 
 	viewSize := sc.GetSize()
 	view.SetSize(viewSize)
@@ -78,47 +140,10 @@ func (sc *StackContainer) Update() error {
 		return nil
 	}
 
-	if sc.transitioning {
-		// Update transition position
-		sc.transitionPos += sc.transitionSpeed
-		if sc.transitionPos >= 1 {
+	if sc.transition.active {
+		if sc.transition.step(0, sc.GetSize().Width) {
 			sc.finishTransition()
 		}
-
-		// Calculate positions for views
-		width := sc.GetSize().Width
-		if sc.pushing && len(sc.stack) >= 2 {
-			// Move previous view left and new view in from right
-			prevView := sc.stack[len(sc.stack)-2]
-			currentView := sc.stack[len(sc.stack)-1]
-
-			prevView.SetPosition(Position{
-				X:        -width * sc.transitionPos,
-				Y:        0,
-				Relative: true,
-			})
-
-			currentView.SetPosition(Position{
-				X:        width * (1 - sc.transitionPos),
-				Y:        0,
-				Relative: true,
-			})
-		} else if !sc.pushing && len(sc.stack) >= 2 {
-			// Move current view right and previous view in from left
-			currentView := sc.stack[len(sc.stack)-1]
-			previousView := sc.stack[len(sc.stack)-2]
-
-			currentView.SetPosition(Position{
-				X:        width * sc.transitionPos,
-				Y:        0,
-				Relative: true,
-			})
-			previousView.SetPosition(Position{
-				X:        -width * (1 - sc.transitionPos),
-				Y:        0,
-				Relative: true,
-			})
-		}
 	}
 
 	return sc.BaseContainer.Update()
@@ -126,13 +151,12 @@ func (sc *StackContainer) Update() error {
 
 // Pop removes the top view from the stack with a transition
 func (sc *StackContainer) Pop() {
-	if len(sc.stack) <= 1 || sc.transitioning {
+	if len(sc.stack) <= 1 || sc.transition.active {
 		return
 	}
 
-	sc.transitioning = true
 	sc.pushing = false
-	sc.transitionPos = 0
+	sc.transition.start(sc.stack[len(sc.stack)-1], sc.stack[len(sc.stack)-2], false)
 
 	// Previous view should already be in the visual hierarchy
 	// Just need to ensure it's positioned correctly
@@ -146,8 +170,6 @@ func (sc *StackContainer) Pop() {
 
 // finishTransition completes the current transition
 func (sc *StackContainer) finishTransition() {
-	sc.transitionPos = 1
-
 	if !sc.pushing {
 		// When popping, remove the top view after transition
 		currentView := sc.stack[len(sc.stack)-1]
@@ -164,8 +186,6 @@ func (sc *StackContainer) finishTransition() {
 			})
 		}
 	}
-
-	sc.transitioning = false
 }
 
 // Draw overrides the BaseContainer.Draw method to implement clipping
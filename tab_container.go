@@ -0,0 +1,748 @@
+package ebui
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ FocusableComponent = &TabContainer{}
+
+// TabPlacement defines where the tab strip is drawn relative to the content
+type TabPlacement int
+
+const (
+	TabPlacementTop TabPlacement = iota
+	TabPlacementBottom
+	TabPlacementLeft
+)
+
+// TabColors represents the color scheme for a tab container
+type TabColors struct {
+	Strip        color.Color
+	Tab          color.Color
+	TabHovered   color.Color
+	TabActive    color.Color
+	Text         color.Color
+	TextActive   color.Color
+	CloseHovered color.Color
+	Border       color.Color
+	FocusBorder  color.Color
+}
+
+// DefaultTabColors returns a default color scheme for tab containers
+func DefaultTabColors() TabColors {
+	return TabColors{
+		Strip:        color.RGBA{180, 180, 180, 255},
+		Tab:          color.RGBA{200, 200, 200, 255},
+		TabHovered:   color.RGBA{220, 220, 220, 255},
+		TabActive:    color.RGBA{240, 240, 240, 255},
+		Text:         color.RGBA{60, 60, 60, 255},
+		TextActive:   color.Black,
+		CloseHovered: color.RGBA{230, 120, 120, 255},
+		Border:       color.RGBA{150, 150, 150, 255},
+		FocusBorder:  color.Black,
+	}
+}
+
+// Tab is a single page of a TabContainer
+type Tab struct {
+	title    string
+	content  Component
+	closable bool
+}
+
+// GetTitle returns the title shown on the tab
+func (tab *Tab) GetTitle() string {
+	return tab.title
+}
+
+// SetTitle sets the title shown on the tab
+func (tab *Tab) SetTitle(title string) {
+	tab.title = title
+}
+
+// GetContent returns the content pane of the tab
+func (tab *Tab) GetContent() Component {
+	return tab.content
+}
+
+// IsClosable returns whether the tab shows a close button
+func (tab *Tab) IsClosable() bool {
+	return tab.closable
+}
+
+// SetClosable sets whether the tab shows a close button
+func (tab *Tab) SetClosable(closable bool) {
+	tab.closable = closable
+}
+
+// TabContainer shows one content pane at a time, selected through a strip of tabs
+type TabContainer struct {
+	*BaseFocusable
+	*BaseContainer
+	tabs         []*Tab
+	activeIndex  int
+	placement    TabPlacement
+	stripSize    float64 // Height of the strip for top/bottom placement, width for left placement
+	tabPadding   float64
+	closeSize    float64
+	closable     bool
+	reorderable  bool
+	hoveredTab   int
+	hoveredClose int
+	pressedClose int
+	draggingTab  int
+	isActive     bool // Whether keyboard shortcuts are routed to this container
+	wasPressed   bool // Whether a mouse press reached this container in the current frame
+	isFocused    bool
+	colors       TabColors
+	font         font.Face
+	transition   slideTransition
+	onTabChange  func(index int)
+	onTabClose   func(tab *Tab)
+	onTabReorder func(from, to int)
+}
+
+// WithTabPlacement sets where the tab strip is drawn
+func WithTabPlacement(placement TabPlacement) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.placement = placement
+		}
+	}
+}
+
+// WithTabStripSize sets the height of the tab strip, or its width for left placement
+func WithTabStripSize(size float64) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.stripSize = size
+		}
+	}
+}
+
+// WithClosableTabs makes newly added tabs closable
+func WithClosableTabs() ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.closable = true
+		}
+	}
+}
+
+// WithReorderableTabs allows tabs to be reordered by dragging them
+func WithReorderableTabs() ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.reorderable = true
+		}
+	}
+}
+
+// WithTabColors sets the colors for the tab container
+func WithTabColors(colors TabColors) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.colors = colors
+		}
+	}
+}
+
+// WithTabChangeHandler sets the handler called when the active tab changes
+func WithTabChangeHandler(handler func(index int)) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.onTabChange = handler
+		}
+	}
+}
+
+// WithTabCloseHandler sets the handler called after a tab is closed
+func WithTabCloseHandler(handler func(tab *Tab)) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.onTabClose = handler
+		}
+	}
+}
+
+// WithTabReorderHandler sets the handler called when a tab is moved
+func WithTabReorderHandler(handler func(from, to int)) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TabContainer); ok {
+			t.onTabReorder = handler
+		}
+	}
+}
+
+// NewTabContainer creates a new tab container.
+// Switching tabs is instant unless WithTransitionSpeed is used to enable the slide transition.
+func NewTabContainer(opts ...ComponentOpt) *TabContainer {
+	colors := DefaultTabColors()
+	t := &TabContainer{
+		BaseFocusable: NewBaseFocusable(),
+		BaseContainer: NewBaseContainer(opts...),
+		activeIndex:   -1,
+		placement:     TabPlacementTop,
+		tabPadding:    10,
+		closeSize:     12,
+		hoveredTab:    -1,
+		hoveredClose:  -1,
+		pressedClose:  -1,
+		draggingTab:   -1,
+		colors:        colors,
+		font:          basicfont.Face7x13,
+		onTabChange:   func(int) {},
+		onTabClose:    func(*Tab) {},
+		onTabReorder:  func(int, int) {},
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if t.stripSize == 0 {
+		// Left placement needs room for the titles
+		t.stripSize = 28
+		if t.placement == TabPlacementLeft {
+			t.stripSize = 120
+		}
+	}
+
+	t.SetBackground(t.colors.TabActive)
+	t.registerEventListeners()
+
+	return t
+}
+
+func (t *TabContainer) registerEventListeners() {
+	t.AddEventListener(MouseDown, func(e *Event) {
+		// A click within the container, including its content, routes keyboard shortcuts here,
+		// unless it is within a nested tab container that takes them instead
+		t.wasPressed = true
+		t.isActive = t.isInnermostOnPath(e)
+
+		if e.Target != t || e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+
+		index := t.tabAt(e.MouseX, e.MouseY)
+		if index == -1 {
+			return
+		}
+		if t.isOverClose(index, e.MouseX, e.MouseY) {
+			t.pressedClose = index
+			return
+		}
+		t.SetActiveTab(index)
+	})
+
+	t.AddEventListener(MouseUp, func(e *Event) {
+		if e.Target != t || e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+
+		if t.pressedClose != -1 && t.isOverClose(t.pressedClose, e.MouseX, e.MouseY) {
+			t.CloseTab(t.pressedClose)
+		}
+		t.pressedClose = -1
+	})
+
+	t.AddEventListener(MouseMove, func(e *Event) {
+		if e.Target != t {
+			t.hoveredTab = -1
+			t.hoveredClose = -1
+			return
+		}
+		t.hoveredTab = t.tabAt(e.MouseX, e.MouseY)
+		t.hoveredClose = -1
+		if t.hoveredTab != -1 && t.isOverClose(t.hoveredTab, e.MouseX, e.MouseY) {
+			t.hoveredClose = t.hoveredTab
+		}
+	})
+
+	t.AddEventListener(MouseLeave, func(e *Event) {
+		if e.Target != t {
+			return
+		}
+		t.hoveredTab = -1
+		t.hoveredClose = -1
+	})
+
+	t.AddEventListener(DragStart, func(e *Event) {
+		if e.Target != t || !t.reorderable || e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		index := t.tabAt(e.MouseX, e.MouseY)
+		if index != -1 && !t.isOverClose(index, e.MouseX, e.MouseY) {
+			t.draggingTab = index
+		}
+	})
+
+	t.AddEventListener(Drag, func(e *Event) {
+		if e.Target != t || t.draggingTab == -1 {
+			return
+		}
+		if index := t.tabAt(e.MouseX, e.MouseY); index != -1 && index != t.draggingTab {
+			t.MoveTab(t.draggingTab, index)
+			t.draggingTab = index
+		}
+	})
+
+	t.AddEventListener(DragEnd, func(e *Event) {
+		t.draggingTab = -1
+	})
+
+	t.AddEventListener(Focus, func(e *Event) {
+		t.isFocused = true
+		t.isActive = true
+	})
+
+	t.AddEventListener(Blur, func(e *Event) {
+		t.isFocused = false
+	})
+}
+
+func (t *TabContainer) Update() error {
+	t.handleInput()
+
+	if t.transition.active {
+		pos, size := t.getContentBounds()
+		if t.transition.step(pos.X, size.Width) {
+			t.finishTransition()
+		}
+	}
+
+	return t.BaseContainer.Update()
+}
+
+// isInnermostOnPath returns whether the container is the innermost tab container on the event path
+func (t *TabContainer) isInnermostOnPath(e *Event) bool {
+	for i := len(e.Path) - 1; i >= 0; i-- {
+		if container, ok := e.Path[i].(*TabContainer); ok {
+			return container == t
+		}
+	}
+	return e.Target == t
+}
+
+func (t *TabContainer) handleInput() {
+	// Clicking anywhere else, including a window in front of the container, stops routing keyboard shortcuts here.
+	// Mouse events are dispatched before components update, so a press that reached the container is already seen.
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !t.wasPressed {
+		t.isActive = false
+	}
+	t.wasPressed = false
+
+	if !t.isActive || t.IsHidden() || t.IsDisabled() {
+		return
+	}
+
+	ctrlPressed := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	if ctrlPressed && inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		// A modal opened over the container takes the shortcut away from it
+		if isOutsideInputTrap(t) {
+			return
+		}
+		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+			t.PreviousTab()
+		} else {
+			t.NextTab()
+		}
+		return
+	}
+
+	if !t.isFocused {
+		return
+	}
+
+	// Arrow keys switch tabs while the tab strip itself is focused
+	prevKey, nextKey := ebiten.KeyArrowLeft, ebiten.KeyArrowRight
+	if t.placement == TabPlacementLeft {
+		prevKey, nextKey = ebiten.KeyArrowUp, ebiten.KeyArrowDown
+	}
+	if inpututil.IsKeyJustPressed(prevKey) {
+		t.PreviousTab()
+	} else if inpututil.IsKeyJustPressed(nextKey) {
+		t.NextTab()
+	}
+}
+
+func (t *TabContainer) Draw(screen *ebiten.Image) {
+	if t.IsHidden() {
+		return
+	}
+
+	// Draw the container's background and debug info
+	t.BaseComponent.Draw(screen)
+
+	t.drawStrip(screen)
+
+	// Draw the content panes clipped to the content area
+	absPos := t.GetAbsolutePosition()
+	pos, size := t.getContentBounds()
+	clip := image.Rect(
+		int(absPos.X+pos.X),
+		int(absPos.Y+pos.Y),
+		int(absPos.X+pos.X+size.Width),
+		int(absPos.Y+pos.Y+size.Height),
+	)
+	subScreen := screen.SubImage(clip).(*ebiten.Image)
+	for _, child := range t.children {
+		child.Draw(subScreen)
+	}
+}
+
+func (t *TabContainer) drawStrip(screen *ebiten.Image) {
+	strip := t.getStripRect()
+	stripImg := GetCache().ImageWithColor(strip.Dx(), strip.Dy(), t.colors.Strip)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64(strip.Min.X), float64(strip.Min.Y))
+	screen.DrawImage(stripImg, op)
+
+	stripScreen := screen.SubImage(strip).(*ebiten.Image)
+	metrics := t.font.Metrics()
+
+	for i, tab := range t.tabs {
+		rect := t.getTabRect(i)
+
+		tabColor := t.colors.Tab
+		textColor := t.colors.Text
+		switch {
+		case i == t.activeIndex:
+			tabColor = t.colors.TabActive
+			textColor = t.colors.TextActive
+		case i == t.hoveredTab:
+			tabColor = t.colors.TabHovered
+		}
+
+		tabImg := GetCache().ImageWithColor(rect.Dx(), rect.Dy(), tabColor)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(rect.Min.X), float64(rect.Min.Y))
+		stripScreen.DrawImage(tabImg, op)
+
+		border := GetCache().BorderImageWithColor(rect.Dx(), rect.Dy(), t.colors.Border)
+		stripScreen.DrawImage(border, op)
+
+		textY := float64(rect.Min.Y) + (float64(rect.Dy())-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil())
+		text.Draw(stripScreen, tab.title, t.font, rect.Min.X+int(t.tabPadding), int(textY), textColor)
+
+		if tab.closable {
+			t.drawCloseButton(stripScreen, i, textColor)
+		}
+	}
+
+	if t.isFocused && t.activeIndex != -1 {
+		// Draw the focus border 1px around the active tab
+		rect := t.getTabRect(t.activeIndex)
+		focusBorder := GetCache().BorderImageWithColor(rect.Dx()+2, rect.Dy()+2, t.colors.FocusBorder)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(rect.Min.X-1), float64(rect.Min.Y-1))
+		screen.DrawImage(focusBorder, op)
+	}
+}
+
+func (t *TabContainer) drawCloseButton(screen *ebiten.Image, index int, crossColor color.Color) {
+	rect := t.getCloseRect(index)
+
+	if index == t.hoveredClose {
+		hoverImg := GetCache().ImageWithColor(rect.Dx(), rect.Dy(), t.colors.CloseHovered)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(rect.Min.X), float64(rect.Min.Y))
+		screen.DrawImage(hoverImg, op)
+	}
+
+	// Draw a small cross one pixel at a time
+	size := rect.Dx() - 6
+	dot := GetCache().ImageWithColor(1, 1, crossColor)
+	for i := 0; i < size; i++ {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(rect.Min.X+3+i), float64(rect.Min.Y+3+i))
+		screen.DrawImage(dot, op)
+		op = &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(rect.Min.X+3+i), float64(rect.Min.Y+3+size-1-i))
+		screen.DrawImage(dot, op)
+	}
+}
+
+// getStripRect returns the absolute rectangle of the tab strip
+func (t *TabContainer) getStripRect() image.Rectangle {
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+
+	switch t.placement {
+	case TabPlacementBottom:
+		return image.Rect(int(pos.X), int(pos.Y+size.Height-t.stripSize), int(pos.X+size.Width), int(pos.Y+size.Height))
+	case TabPlacementLeft:
+		return image.Rect(int(pos.X), int(pos.Y), int(pos.X+t.stripSize), int(pos.Y+size.Height))
+	default:
+		return image.Rect(int(pos.X), int(pos.Y), int(pos.X+size.Width), int(pos.Y+t.stripSize))
+	}
+}
+
+// getContentBounds returns the area available to content panes, relative to the container
+func (t *TabContainer) getContentBounds() (Position, Size) {
+	pos := Position{Relative: true}
+	size := t.GetSize()
+
+	switch t.placement {
+	case TabPlacementTop:
+		pos.Y = t.stripSize
+		size.Height -= t.stripSize
+	case TabPlacementBottom:
+		size.Height -= t.stripSize
+	case TabPlacementLeft:
+		pos.X = t.stripSize
+		size.Width -= t.stripSize
+	}
+	return pos, size
+}
+
+// getTabLength returns the extent of a tab along the strip
+func (t *TabContainer) getTabLength(index int) float64 {
+	if t.placement == TabPlacementLeft {
+		return float64(t.font.Metrics().Height.Ceil()) + t.tabPadding*2
+	}
+
+	tab := t.tabs[index]
+	length := float64(font.MeasureString(t.font, tab.title).Ceil()) + t.tabPadding*2
+	if tab.closable {
+		length += t.closeSize + t.tabPadding/2
+	}
+	return length
+}
+
+// getTabRect returns the absolute rectangle of the tab at the given index
+func (t *TabContainer) getTabRect(index int) image.Rectangle {
+	strip := t.getStripRect()
+
+	offset := 0.0
+	for i := 0; i < index; i++ {
+		offset += t.getTabLength(i)
+	}
+	length := t.getTabLength(index)
+
+	if t.placement == TabPlacementLeft {
+		return image.Rect(strip.Min.X, strip.Min.Y+int(offset), strip.Max.X, strip.Min.Y+int(offset+length))
+	}
+	return image.Rect(strip.Min.X+int(offset), strip.Min.Y, strip.Min.X+int(offset+length), strip.Max.Y)
+}
+
+// getCloseRect returns the absolute rectangle of the close button of the tab at the given index
+func (t *TabContainer) getCloseRect(index int) image.Rectangle {
+	rect := t.getTabRect(index)
+	x := rect.Max.X - int(t.tabPadding/2) - int(t.closeSize)
+	y := rect.Min.Y + (rect.Dy()-int(t.closeSize))/2
+	return image.Rect(x, y, x+int(t.closeSize), y+int(t.closeSize))
+}
+
+// tabAt returns the index of the tab at the given point, or -1
+func (t *TabContainer) tabAt(x, y float64) int {
+	pt := image.Pt(int(x), int(y))
+	if !pt.In(t.getStripRect()) {
+		return -1
+	}
+	for i := range t.tabs {
+		if pt.In(t.getTabRect(i)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *TabContainer) isOverClose(index int, x, y float64) bool {
+	if index < 0 || index >= len(t.tabs) || !t.tabs[index].closable {
+		return false
+	}
+	return image.Pt(int(x), int(y)).In(t.getCloseRect(index))
+}
+
+// layoutPane sizes and positions a content pane to fill the content area
+func (t *TabContainer) layoutPane(pane Component) {
+	pos, size := t.getContentBounds()
+	pane.SetSize(size)
+	pane.SetPosition(pos)
+}
+
+// finishTransition removes the outgoing pane once the slide has completed
+func (t *TabContainer) finishTransition() {
+	t.transition.active = false
+	if t.transition.from != nil {
+		t.BaseContainer.RemoveChild(t.transition.from)
+	}
+	if t.transition.to != nil {
+		t.layoutPane(t.transition.to)
+	}
+	t.transition.from = nil
+	t.transition.to = nil
+}
+
+// Public API methods
+
+// AddTab appends a new tab showing the given content and returns it
+func (t *TabContainer) AddTab(title string, content Component) *Tab {
+	tab := &Tab{
+		title:    title,
+		content:  content,
+		closable: t.closable,
+	}
+	content.SetParent(t)
+	t.tabs = append(t.tabs, tab)
+
+	if t.activeIndex == -1 {
+		t.SetActiveTab(len(t.tabs) - 1)
+	}
+	return tab
+}
+
+// CloseTab removes the tab at the given index and notifies the close handler
+func (t *TabContainer) CloseTab(index int) {
+	tab := t.RemoveTab(index)
+	if tab != nil && t.onTabClose != nil {
+		t.onTabClose(tab)
+	}
+}
+
+// RemoveTab removes the tab at the given index without notifying the close handler
+func (t *TabContainer) RemoveTab(index int) *Tab {
+	if index < 0 || index >= len(t.tabs) {
+		return nil
+	}
+	if t.transition.active {
+		t.finishTransition()
+	}
+
+	tab := t.tabs[index]
+	t.tabs = append(t.tabs[:index], t.tabs[index+1:]...)
+	t.hoveredTab = -1
+	t.hoveredClose = -1
+
+	switch {
+	case index == t.activeIndex:
+		t.BaseContainer.RemoveChild(tab.content)
+		t.activeIndex = -1
+		if len(t.tabs) > 0 {
+			t.SetActiveTab(min(index, len(t.tabs)-1))
+		} else if t.onTabChange != nil {
+			t.onTabChange(-1)
+		}
+	case index < t.activeIndex:
+		t.activeIndex--
+	}
+
+	return tab
+}
+
+// MoveTab moves the tab at index from to index to
+func (t *TabContainer) MoveTab(from, to int) {
+	if from < 0 || from >= len(t.tabs) || to < 0 || to >= len(t.tabs) || from == to {
+		return
+	}
+
+	tab := t.tabs[from]
+	t.tabs = append(t.tabs[:from], t.tabs[from+1:]...)
+	t.tabs = append(t.tabs[:to], append([]*Tab{tab}, t.tabs[to:]...)...)
+
+	// Keep the same tab active
+	switch {
+	case t.activeIndex == from:
+		t.activeIndex = to
+	case from < t.activeIndex && to >= t.activeIndex:
+		t.activeIndex--
+	case from > t.activeIndex && to <= t.activeIndex:
+		t.activeIndex++
+	}
+
+	if t.onTabReorder != nil {
+		t.onTabReorder(from, to)
+	}
+}
+
+// SetActiveTab switches to the tab at the given index
+func (t *TabContainer) SetActiveTab(index int) {
+	if index < 0 || index >= len(t.tabs) || index == t.activeIndex {
+		return
+	}
+	if t.transition.active {
+		t.finishTransition()
+	}
+
+	previous := t.activeIndex
+	pane := t.tabs[index].content
+	t.layoutPane(pane)
+	t.BaseContainer.AddChild(pane)
+	t.activeIndex = index
+
+	if previous != -1 {
+		outgoing := t.tabs[previous].content
+		if t.transition.speed > 0 {
+			// Slide in from the side of the newly selected tab, starting offscreen
+			pos, size := t.getContentBounds()
+			t.transition.start(outgoing, pane, index > previous)
+			if index > previous {
+				setPositionX(pane, pos.X+size.Width)
+			} else {
+				setPositionX(pane, pos.X-size.Width)
+			}
+		} else {
+			t.BaseContainer.RemoveChild(outgoing)
+		}
+	}
+
+	if t.onTabChange != nil {
+		t.onTabChange(index)
+	}
+}
+
+// GetActiveTab returns the index of the active tab, or -1 if there are no tabs
+func (t *TabContainer) GetActiveTab() int {
+	return t.activeIndex
+}
+
+// GetTab returns the tab at the given index
+func (t *TabContainer) GetTab(index int) *Tab {
+	if index < 0 || index >= len(t.tabs) {
+		return nil
+	}
+	return t.tabs[index]
+}
+
+// GetTabCount returns the number of tabs
+func (t *TabContainer) GetTabCount() int {
+	return len(t.tabs)
+}
+
+// NextTab switches to the next tab, wrapping around to the first
+func (t *TabContainer) NextTab() {
+	if len(t.tabs) == 0 {
+		return
+	}
+	t.SetActiveTab((t.activeIndex + 1) % len(t.tabs))
+}
+
+// PreviousTab switches to the previous tab, wrapping around to the last
+func (t *TabContainer) PreviousTab() {
+	if len(t.tabs) == 0 {
+		return
+	}
+	t.SetActiveTab((t.activeIndex - 1 + len(t.tabs)) % len(t.tabs))
+}
+
+func (t *TabContainer) SetSize(size Size) {
+	t.BaseContainer.SetSize(size)
+	for _, child := range t.children {
+		t.layoutPane(child)
+	}
+}
+
+// SetColors sets the color scheme for the tab container
+func (t *TabContainer) SetColors(colors TabColors) {
+	t.colors = colors
+	t.SetBackground(colors.TabActive)
+}
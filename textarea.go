@@ -0,0 +1,1058 @@
+package ebui
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.design/x/clipboard"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ FocusableComponent = &TextArea{}
+var _ Scrollable = &TextArea{}
+
+// SubmitMode controls which Enter key combination submits a TextArea
+type SubmitMode int
+
+const (
+	SubmitNever        SubmitMode = iota // Enter always inserts a newline
+	SubmitOnEnter                        // Enter submits, Shift+Enter inserts a newline
+	SubmitOnShiftEnter                   // Shift+Enter submits, Enter inserts a newline
+)
+
+// TextAreaColors represents the color scheme for a text area
+type TextAreaColors struct {
+	Text        color.Color
+	Background  color.Color
+	Cursor      color.Color
+	Selection   color.Color
+	FocusBorder color.Color
+	Track       color.Color
+	Thumb       color.Color
+	ThumbDrag   color.Color
+}
+
+// DefaultTextAreaColors returns a default color scheme for text areas
+func DefaultTextAreaColors() TextAreaColors {
+	return TextAreaColors{
+		Text:        color.Black,
+		Background:  color.White,
+		Cursor:      color.Black,
+		Selection:   color.RGBA{100, 149, 237, 127}, // Dodger Blue
+		FocusBorder: color.Black,
+		Track:       color.RGBA{200, 200, 200, 255},
+		Thumb:       color.RGBA{160, 160, 160, 255},
+		ThumbDrag:   color.RGBA{120, 120, 120, 255},
+	}
+}
+
+// TextArea is a multi-line text input with word wrapping and vertical scrolling
+type TextArea struct {
+	*BaseFocusable
+	*BaseContainer
+	text              []rune
+	lines             []textSpan // Wrapped lines
+	wrapWidth         float64    // Width the lines were wrapped at
+	cursorPos         int
+	preferredX        float64 // X position kept when moving the cursor vertically, -1 if unset
+	selectionStart    int
+	selectionEnd      int
+	isSelecting       bool
+	scrollOffset      Position
+	scrollBarWidth    float64
+	isScrollBarHidden bool
+	isDraggingThumb   bool
+	dragStartY        float64
+	dragStartOffset   float64
+	font              font.Face
+	lineSpacing       int
+	colors            TextAreaColors
+	isFocused         bool
+	lastBlink         time.Time
+	showCursor        bool
+	repeatKey         ebiten.Key
+	repeatStart       time.Time
+	lastRepeat        time.Time
+	maxLines          int
+	submitMode        SubmitMode
+	onChange          func(string)
+	onSubmit          func(string)
+}
+
+// WithTextAreaColors sets the colors for the text area
+func WithTextAreaColors(colors TextAreaColors) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextArea); ok {
+			t.colors = colors
+		}
+	}
+}
+
+// WithMaxLines limits the number of lines (separated by newlines) in the text area
+func WithMaxLines(lines int) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextArea); ok {
+			t.maxLines = lines
+		}
+	}
+}
+
+// WithSubmitMode sets which Enter key combination submits the text area
+func WithSubmitMode(mode SubmitMode) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextArea); ok {
+			t.submitMode = mode
+		}
+	}
+}
+
+// NewTextArea creates a new multi-line text area
+func NewTextArea(opts ...ComponentOpt) *TextArea {
+	t := &TextArea{
+		BaseFocusable:  NewBaseFocusable(),
+		BaseContainer:  NewBaseContainer(opts...),
+		text:           make([]rune, 0),
+		preferredX:     -1,
+		selectionStart: -1,
+		selectionEnd:   -1,
+		scrollBarWidth: 12,
+		font:           basicfont.Face7x13,
+		lineSpacing:    2,
+		colors:         DefaultTextAreaColors(),
+		lastBlink:      time.Now(),
+		repeatKey:      -1,
+		submitMode:     SubmitNever,
+		onChange:       func(string) {},
+		onSubmit:       func(string) {},
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	t.wrapLines()
+	t.registerEventListeners()
+	return t
+}
+
+func (t *TextArea) registerEventListeners() {
+	t.AddEventListener(MouseDown, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		t.Focus()
+
+		if t.isOverScrollBar(e.MouseX, e.MouseY) {
+			return
+		}
+
+		index := t.getIndexAt(e.MouseX, e.MouseY)
+		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+			prevPos := t.cursorPos
+			t.cursorPos = index
+			t.updateSelection(prevPos, true)
+		} else {
+			t.cursorPos = index
+			t.selectionStart = index
+			t.selectionEnd = index
+		}
+		t.preferredX = -1
+		t.isSelecting = true
+		t.resetBlink()
+	})
+
+	t.AddEventListener(MouseUp, func(e *Event) {
+		t.isSelecting = false
+	})
+
+	t.AddEventListener(DragStart, func(e *Event) {
+		if e.MouseButton == ebiten.MouseButtonLeft && t.isOverScrollThumb(e.MouseX, e.MouseY) {
+			t.isSelecting = false
+			t.isDraggingThumb = true
+			t.dragStartY = e.MouseY
+			t.dragStartOffset = t.scrollOffset.Y
+		}
+	})
+
+	t.AddEventListener(Drag, func(e *Event) {
+		if t.isDraggingThumb {
+			trackSpace := t.getViewportHeight() - t.getScrollThumbHeight()
+			if trackSpace <= 0 {
+				return
+			}
+			scrollOffset := t.GetScrollOffset()
+			scrollOffset.Y = t.dragStartOffset + (e.MouseY-t.dragStartY)*t.getMaxScroll()/trackSpace
+			t.SetScrollOffset(scrollOffset)
+			return
+		}
+
+		if t.isSelecting && t.isFocused {
+			t.cursorPos = t.getIndexAt(e.MouseX, e.MouseY)
+			t.selectionEnd = t.cursorPos
+			t.ensureCursorVisible()
+		}
+	})
+
+	t.AddEventListener(DragEnd, func(e *Event) {
+		t.isDraggingThumb = false
+		t.isSelecting = false
+	})
+
+	t.AddEventListener(Wheel, func(e *Event) {
+		scrollOffset := t.GetScrollOffset()
+		scrollOffset.Y -= e.WheelDeltaY * t.getLineHeight() * 3
+		t.SetScrollOffset(scrollOffset)
+	})
+
+	t.AddEventListener(Focus, func(e *Event) {
+		t.Focus()
+	})
+
+	t.AddEventListener(Blur, func(e *Event) {
+		t.Blur()
+	})
+}
+
+func (t *TextArea) Update() error {
+	if t.getWrapWidth() != t.wrapWidth {
+		t.wrapLines()
+	}
+
+	if t.isFocused {
+		t.handleKeyboardInput()
+		if time.Since(t.lastBlink) > 530*time.Millisecond {
+			t.showCursor = !t.showCursor
+			t.lastBlink = time.Now()
+		}
+	}
+	return t.BaseContainer.Update()
+}
+
+func (t *TextArea) handleKeyboardInput() {
+	handled := t.handleSpecialKeys()
+	if !handled {
+		t.handleCharacterInput()
+	}
+	t.handleKeyRepeat()
+}
+
+func (t *TextArea) handleCharacterInput() {
+	inputChars := ebiten.AppendInputChars(nil)
+
+	filtered := inputChars[:0]
+	for _, ch := range inputChars {
+		if unicode.IsPrint(ch) {
+			filtered = append(filtered, ch)
+		}
+	}
+	if len(filtered) > 0 {
+		t.insertText(filtered)
+	}
+}
+
+func (t *TextArea) handleSpecialKeys() bool {
+	ctrlPressed := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	shiftPressed := ebiten.IsKeyPressed(ebiten.KeyShift)
+
+	// Define the keys we want to handle
+	keys := []ebiten.Key{
+		ebiten.KeyA,
+		ebiten.KeyX,
+		ebiten.KeyC,
+		ebiten.KeyV,
+		ebiten.KeyLeft,
+		ebiten.KeyRight,
+		ebiten.KeyUp,
+		ebiten.KeyDown,
+		ebiten.KeyPageUp,
+		ebiten.KeyPageDown,
+		ebiten.KeyBackspace,
+		ebiten.KeyDelete,
+		ebiten.KeyEnter,
+		ebiten.KeyHome,
+		ebiten.KeyEnd,
+	}
+
+	handled := false
+	for _, key := range keys {
+		if ebiten.IsKeyPressed(key) {
+			if t.repeatKey != key {
+				t.repeatKey = key
+				t.repeatStart = time.Now()
+				t.lastRepeat = time.Now()
+				handled = t.handleKey(key, ctrlPressed, shiftPressed)
+			}
+		} else if t.repeatKey == key {
+			t.repeatKey = -1
+		}
+	}
+
+	return handled
+}
+
+func (t *TextArea) handleKeyRepeat() {
+	if t.repeatKey == -1 {
+		return
+	}
+
+	now := time.Now()
+	initialDelay := 500 * time.Millisecond
+	repeatDelay := 50 * time.Millisecond
+
+	shouldRepeat := time.Since(t.repeatStart) >= initialDelay &&
+		time.Since(t.lastRepeat) >= repeatDelay
+
+	if !shouldRepeat {
+		return
+	}
+
+	ctrlPressed := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	shiftPressed := ebiten.IsKeyPressed(ebiten.KeyShift)
+
+	// Handle repeatable shortcuts when ctrl is pressed
+	if ctrlPressed {
+		switch t.repeatKey {
+		case ebiten.KeyV:
+			t.handlePaste()
+		case ebiten.KeyLeft, ebiten.KeyRight:
+			// Allow ctrl+arrow keys to repeat for word-by-word movement
+			t.handleKey(t.repeatKey, ctrlPressed, shiftPressed)
+		}
+	} else if t.repeatKey != ebiten.KeyEnter || !t.isSubmitKey(shiftPressed) {
+		// Handle regular key repeats, but never repeat a submit
+		t.handleKey(t.repeatKey, ctrlPressed, shiftPressed)
+	}
+
+	t.lastRepeat = now
+}
+
+func (t *TextArea) handleKey(key ebiten.Key, ctrlPressed, shiftPressed bool) bool {
+	handled := false
+	switch key {
+	case ebiten.KeyA, ebiten.KeyX, ebiten.KeyC, ebiten.KeyV:
+		if ctrlPressed {
+			shortcuts := map[ebiten.Key]func(){
+				ebiten.KeyA: t.selectAll,
+				ebiten.KeyX: t.handleCut,
+				ebiten.KeyC: t.handleCopy,
+				ebiten.KeyV: t.handlePaste,
+			}
+			shortcuts[key]()
+			handled = true
+		}
+	case ebiten.KeyLeft:
+		prevPos := t.cursorPos
+		if ctrlPressed {
+			t.cursorPos = t.findPreviousWordBoundary()
+		} else if t.hasSelection() && !shiftPressed {
+			t.cursorPos, _ = t.getOrderedSelection()
+		} else if t.cursorPos > 0 {
+			t.cursorPos--
+		}
+		t.preferredX = -1
+		t.updateSelection(prevPos, shiftPressed)
+		handled = true
+	case ebiten.KeyRight:
+		prevPos := t.cursorPos
+		if ctrlPressed {
+			t.cursorPos = t.findNextWordBoundary()
+		} else if t.hasSelection() && !shiftPressed {
+			_, t.cursorPos = t.getOrderedSelection()
+		} else if t.cursorPos < len(t.text) {
+			t.cursorPos++
+		}
+		t.preferredX = -1
+		t.updateSelection(prevPos, shiftPressed)
+		handled = true
+	case ebiten.KeyUp:
+		t.moveCursorLines(-1, shiftPressed)
+		handled = true
+	case ebiten.KeyDown:
+		t.moveCursorLines(1, shiftPressed)
+		handled = true
+	case ebiten.KeyPageUp:
+		t.moveCursorLines(-t.getVisibleLineCount(), shiftPressed)
+		handled = true
+	case ebiten.KeyPageDown:
+		t.moveCursorLines(t.getVisibleLineCount(), shiftPressed)
+		handled = true
+	case ebiten.KeyBackspace:
+		t.handleBackspace()
+		handled = true
+	case ebiten.KeyDelete:
+		t.handleDelete()
+		handled = true
+	case ebiten.KeyEnter:
+		if t.isSubmitKey(shiftPressed) {
+			if t.onSubmit != nil {
+				t.onSubmit(string(t.text))
+			}
+		} else {
+			t.insertText([]rune{'\n'})
+		}
+		handled = true
+	case ebiten.KeyHome:
+		prevPos := t.cursorPos
+		if ctrlPressed {
+			t.cursorPos = 0
+		} else {
+			t.cursorPos = t.lines[t.getLineForIndex(t.cursorPos)].start
+		}
+		t.preferredX = -1
+		t.updateSelection(prevPos, shiftPressed)
+		handled = true
+	case ebiten.KeyEnd:
+		prevPos := t.cursorPos
+		if ctrlPressed {
+			t.cursorPos = len(t.text)
+		} else {
+			t.cursorPos = t.getLineCursorEnd(t.getLineForIndex(t.cursorPos))
+		}
+		t.preferredX = -1
+		t.updateSelection(prevPos, shiftPressed)
+		handled = true
+	}
+
+	t.ensureCursorVisible()
+	t.resetBlink()
+
+	return handled
+}
+
+// isSubmitKey returns whether pressing Enter with the given modifier submits the text
+func (t *TextArea) isSubmitKey(shiftPressed bool) bool {
+	switch t.submitMode {
+	case SubmitOnEnter:
+		return !shiftPressed
+	case SubmitOnShiftEnter:
+		return shiftPressed
+	}
+	return false
+}
+
+// moveCursorLines moves the cursor up or down by the given number of wrapped lines,
+// keeping its horizontal position
+func (t *TextArea) moveCursorLines(delta int, shiftPressed bool) {
+	prevPos := t.cursorPos
+	line := t.getLineForIndex(t.cursorPos)
+
+	if t.preferredX < 0 {
+		t.preferredX = t.getXPositionForIndex(t.cursorPos)
+	}
+
+	target := line + delta
+	switch {
+	case target < 0:
+		t.cursorPos = 0
+	case target >= len(t.lines):
+		t.cursorPos = len(t.text)
+	default:
+		t.cursorPos = t.getIndexAtLineX(target, t.preferredX)
+	}
+
+	t.updateSelection(prevPos, shiftPressed)
+}
+
+func (t *TextArea) updateSelection(prevPos int, shiftPressed bool) {
+	if !shiftPressed {
+		t.ClearSelection()
+	} else {
+		if t.selectionStart == -1 {
+			t.selectionStart = prevPos
+		}
+		t.selectionEnd = t.cursorPos
+	}
+}
+
+func (t *TextArea) resetBlink() {
+	t.showCursor = true
+	t.lastBlink = time.Now()
+}
+
+// insertText replaces the selection with the given runes at the cursor position,
+// dropping newlines that would exceed the maximum line count
+func (t *TextArea) insertText(runes []rune) {
+	if t.hasSelection() {
+		t.deleteSelection()
+	}
+
+	allowedNewlines := math.MaxInt
+	if t.maxLines > 0 {
+		allowedNewlines = t.maxLines - t.GetLineCount()
+	}
+
+	filtered := make([]rune, 0, len(runes))
+	for _, ch := range runes {
+		if ch == '\n' {
+			if allowedNewlines <= 0 {
+				continue
+			}
+			allowedNewlines--
+		}
+		filtered = append(filtered, ch)
+	}
+	if len(filtered) == 0 {
+		return
+	}
+
+	newText := make([]rune, 0, len(t.text)+len(filtered))
+	newText = append(newText, t.text[:t.cursorPos]...)
+	newText = append(newText, filtered...)
+	newText = append(newText, t.text[t.cursorPos:]...)
+	t.text = newText
+	t.cursorPos += len(filtered)
+	t.preferredX = -1
+	t.textChanged()
+}
+
+// textChanged re-wraps the text and notifies the change handler
+func (t *TextArea) textChanged() {
+	t.wrapLines()
+	t.ensureCursorVisible()
+	if t.onChange != nil {
+		t.onChange(string(t.text))
+	}
+}
+
+func (t *TextArea) handleBackspace() {
+	if t.hasSelection() {
+		t.deleteSelection()
+	} else if t.cursorPos > 0 {
+		t.text = append(t.text[:t.cursorPos-1], t.text[t.cursorPos:]...)
+		t.cursorPos--
+		t.preferredX = -1
+		t.textChanged()
+	}
+}
+
+func (t *TextArea) handleDelete() {
+	if t.hasSelection() {
+		t.deleteSelection()
+	} else if t.cursorPos < len(t.text) {
+		t.text = append(t.text[:t.cursorPos], t.text[t.cursorPos+1:]...)
+		t.preferredX = -1
+		t.textChanged()
+	}
+}
+
+func (t *TextArea) deleteSelection() {
+	if !t.hasSelection() {
+		return
+	}
+
+	start, end := t.getOrderedSelection()
+	t.text = append(t.text[:start], t.text[end:]...)
+	t.cursorPos = start
+	t.preferredX = -1
+	t.ClearSelection()
+	t.textChanged()
+}
+
+func (t *TextArea) findPreviousWordBoundary() int {
+	pos := t.cursorPos
+
+	// Skip spaces before cursor
+	for pos > 0 && unicode.IsSpace(t.text[pos-1]) {
+		pos--
+	}
+	// Skip word characters
+	for pos > 0 && !unicode.IsSpace(t.text[pos-1]) {
+		pos--
+	}
+	return pos
+}
+
+func (t *TextArea) findNextWordBoundary() int {
+	pos := t.cursorPos
+
+	// Skip current word
+	for pos < len(t.text) && !unicode.IsSpace(t.text[pos]) {
+		pos++
+	}
+	// Skip spaces
+	for pos < len(t.text) && unicode.IsSpace(t.text[pos]) {
+		pos++
+	}
+	return pos
+}
+
+func (t *TextArea) hasSelection() bool {
+	return t.selectionStart != -1 && t.selectionEnd != -1 && t.selectionStart != t.selectionEnd
+}
+
+func (t *TextArea) getOrderedSelection() (int, int) {
+	if t.selectionStart < t.selectionEnd {
+		return t.selectionStart, t.selectionEnd
+	}
+	return t.selectionEnd, t.selectionStart
+}
+
+func (t *TextArea) selectAll() {
+	if len(t.text) > 0 {
+		t.selectionStart = 0
+		t.selectionEnd = len(t.text)
+		t.cursorPos = t.selectionEnd
+		t.resetBlink()
+		t.ensureCursorVisible()
+	}
+}
+
+func (t *TextArea) handleCopy() {
+	if clipboardDisabled {
+		return
+	}
+	if !t.hasSelection() {
+		return
+	}
+	start, end := t.getOrderedSelection()
+	clipboard.Write(clipboard.FmtText, []byte(string(t.text[start:end])))
+}
+
+func (t *TextArea) handleCut() {
+	if clipboardDisabled {
+		return
+	}
+	if !t.hasSelection() {
+		return
+	}
+	t.handleCopy()
+	t.deleteSelection()
+}
+
+func (t *TextArea) handlePaste() {
+	if clipboardDisabled {
+		return
+	}
+
+	bytes := clipboard.Read(clipboard.FmtText)
+	if len(bytes) == 0 {
+		return
+	}
+
+	// Normalize line endings and drop other control characters
+	pasted := strings.ReplaceAll(string(bytes), "\r\n", "\n")
+	filtered := []rune{}
+	for _, ch := range pasted {
+		if ch == '\n' || unicode.IsPrint(ch) {
+			filtered = append(filtered, ch)
+		}
+	}
+	t.insertText(filtered)
+}
+
+// Layout helpers
+
+// wrapLines recalculates the wrapped lines for the current text and width
+func (t *TextArea) wrapLines() {
+	t.wrapWidth = t.getWrapWidth()
+	t.lines = wrapText(t.font, t.text, t.wrapWidth, true)
+	t.clampScrollOffset()
+}
+
+// getWrapWidth returns the width available to text, always leaving room for the scroll bar
+func (t *TextArea) getWrapWidth() float64 {
+	padding := t.GetPadding()
+	return math.Max(1, t.GetSize().Width-padding.Left-padding.Right-t.scrollBarWidth)
+}
+
+func (t *TextArea) getLineHeight() float64 {
+	return float64(t.font.Metrics().Height.Ceil() + t.lineSpacing)
+}
+
+func (t *TextArea) getViewportHeight() float64 {
+	padding := t.GetPadding()
+	return math.Max(0, t.GetSize().Height-padding.Top-padding.Bottom)
+}
+
+func (t *TextArea) getContentHeight() float64 {
+	return float64(len(t.lines)) * t.getLineHeight()
+}
+
+func (t *TextArea) getMaxScroll() float64 {
+	return math.Max(0, t.getContentHeight()-t.getViewportHeight())
+}
+
+func (t *TextArea) getVisibleLineCount() int {
+	return max(1, int(t.getViewportHeight()/t.getLineHeight()))
+}
+
+// getTextRect returns the absolute rectangle text is drawn in
+func (t *TextArea) getTextRect() image.Rectangle {
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+	padding := t.GetPadding()
+	return image.Rect(
+		int(pos.X+padding.Left),
+		int(pos.Y+padding.Top),
+		int(pos.X+size.Width-padding.Right-t.scrollBarWidth),
+		int(pos.Y+size.Height-padding.Bottom),
+	)
+}
+
+// getLineForIndex returns the wrapped line containing the given rune index
+func (t *TextArea) getLineForIndex(index int) int {
+	for i, line := range t.lines {
+		if index < line.start {
+			continue
+		}
+		if index < line.end {
+			return i
+		}
+		// At the end of a line, stay on it unless the next line is a soft-wrapped continuation
+		if index == line.end && (i == len(t.lines)-1 || t.lines[i+1].start != line.end) {
+			return i
+		}
+	}
+	return len(t.lines) - 1
+}
+
+// getLineCursorEnd returns the last cursor position on a wrapped line
+func (t *TextArea) getLineCursorEnd(line int) int {
+	span := t.lines[line]
+	if line < len(t.lines)-1 && t.lines[line+1].start == span.end && span.end > span.start {
+		// Soft-wrapped line, the end position belongs to the next line
+		return span.end - 1
+	}
+	return span.end
+}
+
+// getXPositionForIndex returns the x offset of the given rune index within its line
+func (t *TextArea) getXPositionForIndex(index int) float64 {
+	line := t.lines[t.getLineForIndex(index)]
+	return measureText(t.font, t.text[line.start:index])
+}
+
+// getIndexAtLineX returns the rune index closest to the given x offset on a wrapped line
+func (t *TextArea) getIndexAtLineX(line int, x float64) int {
+	span := t.lines[line]
+	end := t.getLineCursorEnd(line)
+	prevX := 0.0
+	for i := span.start; i < end; i++ {
+		nextX := measureText(t.font, t.text[span.start:i+1])
+		if x < (prevX+nextX)/2 {
+			return i
+		}
+		prevX = nextX
+	}
+	return end
+}
+
+// getIndexAt returns the rune index closest to the given screen position
+func (t *TextArea) getIndexAt(x, y float64) int {
+	rect := t.getTextRect()
+	line := int((y - float64(rect.Min.Y) + t.scrollOffset.Y) / t.getLineHeight())
+	if line < 0 {
+		return 0
+	}
+	if line >= len(t.lines) {
+		return len(t.text)
+	}
+	return t.getIndexAtLineX(line, x-float64(rect.Min.X))
+}
+
+func (t *TextArea) ensureCursorVisible() {
+	lineHeight := t.getLineHeight()
+	lineTop := float64(t.getLineForIndex(t.cursorPos)) * lineHeight
+
+	if lineTop < t.scrollOffset.Y {
+		t.scrollOffset.Y = lineTop
+	}
+	if lineTop+lineHeight > t.scrollOffset.Y+t.getViewportHeight() {
+		t.scrollOffset.Y = lineTop + lineHeight - t.getViewportHeight()
+	}
+	t.clampScrollOffset()
+}
+
+// Drawing
+
+// GetCursorAt returns the text cursor over the text and the default cursor over the scroll bar,
+// unless another cursor was set with WithCursor
+func (t *TextArea) GetCursorAt(x, y float64) ebiten.CursorShapeType {
+	if cursor := t.GetCursor(); cursor != ebiten.CursorShapeDefault {
+		return cursor
+	}
+	if t.isDraggingThumb || t.isOverScrollBar(x, y) {
+		return ebiten.CursorShapeDefault
+	}
+	return ebiten.CursorShapeText
+}
+
+func (t *TextArea) Draw(screen *ebiten.Image) {
+	if t.IsHidden() {
+		return
+	}
+
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+
+	if t.isFocused {
+		// Draw the focus border 1px
+		focusBorder := GetCache().BorderImageWithColor(int(size.Width+2), int(size.Height+2), t.colors.FocusBorder)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-1, pos.Y-1)
+		screen.DrawImage(focusBorder, op)
+	}
+
+	bg := GetCache().ImageWithColor(int(size.Width), int(size.Height), t.colors.Background)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(pos.X, pos.Y)
+	screen.DrawImage(bg, op)
+
+	rect := t.getTextRect()
+	clippedScreen := screen.SubImage(rect).(*ebiten.Image)
+
+	lineHeight := t.getLineHeight()
+	first := int(t.scrollOffset.Y / lineHeight)
+	last := min(len(t.lines)-1, int(math.Ceil((t.scrollOffset.Y+t.getViewportHeight())/lineHeight)))
+	ascent := float64(t.font.Metrics().Ascent.Ceil())
+
+	for i := first; i <= last; i++ {
+		line := t.lines[i]
+		lineY := float64(rect.Min.Y) + float64(i)*lineHeight - t.scrollOffset.Y
+
+		if t.hasSelection() {
+			t.drawLineSelection(clippedScreen, line, float64(rect.Min.X), lineY)
+		}
+
+		text.Draw(
+			clippedScreen,
+			string(t.text[line.start:line.end]),
+			t.font,
+			rect.Min.X,
+			int(lineY+ascent),
+			t.colors.Text,
+		)
+	}
+
+	if t.isFocused && t.showCursor {
+		t.drawCursor(clippedScreen)
+	}
+
+	if t.needsScrollBar() {
+		t.drawScrollBar(screen)
+	}
+
+	t.BaseContainer.Draw(screen)
+}
+
+func (t *TextArea) drawLineSelection(screen *ebiten.Image, line textSpan, x, y float64) {
+	selStart, selEnd := t.getOrderedSelection()
+	start := max(selStart, line.start)
+	end := min(selEnd, line.end)
+	if start > end || (start == end && selEnd <= line.end) {
+		return
+	}
+
+	startX := measureText(t.font, t.text[line.start:start])
+	endX := measureText(t.font, t.text[line.start:end])
+	if selEnd > line.end {
+		// Show that the selection continues past the end of the line
+		endX += measureText(t.font, []rune{' '})
+	}
+	if endX <= startX {
+		return
+	}
+
+	selection := GetCache().ImageWithColor(int(endX-startX), int(t.getLineHeight()), t.colors.Selection)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x+startX, y)
+	screen.DrawImage(selection, op)
+}
+
+func (t *TextArea) drawCursor(screen *ebiten.Image) {
+	rect := t.getTextRect()
+	line := t.getLineForIndex(t.cursorPos)
+	cursorX := float64(rect.Min.X) + t.getXPositionForIndex(t.cursorPos)
+	cursorY := float64(rect.Min.Y) + float64(line)*t.getLineHeight() - t.scrollOffset.Y
+
+	cursor := GetCache().ImageWithColor(1, int(t.getLineHeight()), t.colors.Cursor)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(cursorX, cursorY)
+	screen.DrawImage(cursor, op)
+}
+
+func (t *TextArea) drawScrollBar(screen *ebiten.Image) {
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+	padding := t.GetPadding()
+	trackX := pos.X + size.Width - padding.Right - t.scrollBarWidth
+	trackY := pos.Y + padding.Top
+
+	trackImg := GetCache().ImageWithColor(int(t.scrollBarWidth), int(t.getViewportHeight()), t.colors.Track)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(trackX, trackY)
+	screen.DrawImage(trackImg, op)
+
+	thumbColor := t.colors.Thumb
+	if t.isDraggingThumb {
+		thumbColor = t.colors.ThumbDrag
+	}
+	thumbImg := GetCache().ImageWithColor(int(t.scrollBarWidth), int(t.getScrollThumbHeight()), thumbColor)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(trackX, trackY+t.getScrollThumbPosition())
+	screen.DrawImage(thumbImg, op)
+}
+
+// Scroll bar related methods
+
+func (t *TextArea) needsScrollBar() bool {
+	return !t.isScrollBarHidden && t.getContentHeight() > t.getViewportHeight()
+}
+
+func (t *TextArea) getScrollThumbHeight() float64 {
+	viewportHeight := t.getViewportHeight()
+	contentHeight := t.getContentHeight()
+	if contentHeight <= 0 {
+		return viewportHeight
+	}
+	return math.Min(viewportHeight, math.Max(viewportHeight*viewportHeight/contentHeight, 20)) // Minimum thumb size of 20px
+}
+
+func (t *TextArea) getScrollThumbPosition() float64 {
+	maxScroll := t.getMaxScroll()
+	if maxScroll <= 0 {
+		return 0
+	}
+	return (t.getViewportHeight() - t.getScrollThumbHeight()) * t.scrollOffset.Y / maxScroll
+}
+
+func (t *TextArea) isOverScrollBar(x, y float64) bool {
+	if !t.needsScrollBar() {
+		return false
+	}
+	pos := t.GetAbsolutePosition()
+	size := t.GetSize()
+	padding := t.GetPadding()
+	trackX := pos.X + size.Width - padding.Right - t.scrollBarWidth
+	return x >= trackX && x <= trackX+t.scrollBarWidth &&
+		y >= pos.Y+padding.Top && y <= pos.Y+size.Height-padding.Bottom
+}
+
+func (t *TextArea) isOverScrollThumb(x, y float64) bool {
+	if !t.isOverScrollBar(x, y) {
+		return false
+	}
+	thumbY := t.GetAbsolutePosition().Y + t.GetPadding().Top + t.getScrollThumbPosition()
+	return y >= thumbY && y <= thumbY+t.getScrollThumbHeight()
+}
+
+func (t *TextArea) clampScrollOffset() {
+	t.scrollOffset.X = 0
+	t.scrollOffset.Y = clamp(t.scrollOffset.Y, 0, t.getMaxScroll())
+}
+
+func (t *TextArea) IsWithinBounds(x, y float64) bool {
+	return t.Contains(x, y)
+}
+
+// Public API methods
+
+func (t *TextArea) SetText(text string) {
+	t.text = []rune(strings.ReplaceAll(text, "\r\n", "\n"))
+	t.cursorPos = len(t.text)
+	t.preferredX = -1
+	t.ClearSelection()
+	t.textChanged()
+}
+
+func (t *TextArea) GetText() string {
+	return string(t.text)
+}
+
+// GetLineCount returns the number of lines separated by newlines
+func (t *TextArea) GetLineCount() int {
+	count := 1
+	for _, ch := range t.text {
+		if ch == '\n' {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *TextArea) SetSize(size Size) {
+	t.BaseContainer.SetSize(size)
+	t.wrapLines()
+}
+
+func (t *TextArea) SetFont(font font.Face) {
+	t.font = font
+	t.wrapLines()
+}
+
+// SetColors sets the color scheme for the text area
+func (t *TextArea) SetColors(colors TextAreaColors) {
+	t.colors = colors
+}
+
+func (t *TextArea) Focus() {
+	t.isFocused = true
+	t.resetBlink()
+	t.ensureCursorVisible()
+}
+
+func (t *TextArea) Blur() {
+	t.isFocused = false
+	t.showCursor = false
+	t.isSelecting = false
+	t.ClearSelection()
+}
+
+func (t *TextArea) IsFocused() bool {
+	return t.isFocused
+}
+
+func (t *TextArea) Select(start, end int) {
+	start = max(start, 0)
+	end = min(end, len(t.text))
+	if start > end {
+		start, end = end, start
+	}
+
+	t.selectionStart = start
+	t.selectionEnd = end
+	t.cursorPos = end
+	t.resetBlink()
+	t.ensureCursorVisible()
+}
+
+func (t *TextArea) ClearSelection() {
+	t.selectionStart = -1
+	t.selectionEnd = -1
+}
+
+func (t *TextArea) GetScrollOffset() Position {
+	return t.scrollOffset
+}
+
+func (t *TextArea) SetScrollOffset(offset Position) {
+	t.scrollOffset = offset
+	t.clampScrollOffset()
+}
+
+func (t *TextArea) ScrollToTop() {
+	t.SetScrollOffset(Position{Y: 0})
+}
+
+func (t *TextArea) ScrollToBottom() {
+	t.SetScrollOffset(Position{Y: t.getMaxScroll()})
+}
+
+func (t *TextArea) HideScrollBar() {
+	t.isScrollBarHidden = true
+}
+
+func (t *TextArea) ShowScrollBar() {
+	t.isScrollBarHidden = false
+}
+
+func (t *TextArea) IsScrollBarHidden() bool {
+	return t.isScrollBarHidden
+}
@@ -59,14 +59,59 @@ type TextInput struct {
 	maskChar         rune
 	focusable        bool
 	tabIndex         int
+	undoStack        []textInputState
+	redoStack        []textInputState
+	undoLimit        int
+	lastEdit         textEditKind
+	lastEditTime     time.Time
+	maxLength        int
+	filter           InputFilter
+	validator        func(string) error
+	validationErr    error
+	placeholder      string
+	placeholderColor color.Color
+	errorColor       color.Color
 }
 
+// InputFilter reports whether a character may be entered into a text input
+type InputFilter func(r rune) bool
+
+// DigitsOnly is an InputFilter that accepts the ASCII digits 0 to 9, so the text can be parsed with strconv
+func DigitsOnly(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// Alphanumeric is an InputFilter that accepts letters and digits
+func Alphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// textInputState is a snapshot of the text and cursor used for undo/redo
+type textInputState struct {
+	text           []rune
+	cursorPos      int
+	selectionStart int
+	selectionEnd   int
+}
+
+// textEditKind categorizes edits so consecutive edits of the same kind can be merged into one undo step
+type textEditKind int
+
+const (
+	textEditNone textEditKind = iota
+	textEditTyping
+	textEditDeleting
+	textEditOther
+)
+
 type TextInputColors struct {
 	Text        color.Color
 	Background  color.Color
 	Cursor      color.Color
 	Selection   color.Color
 	FocusBorder color.Color
+	Placeholder color.Color
+	Error       color.Color
 }
 
 func DefaultTextInputColors() TextInputColors {
@@ -76,6 +121,8 @@ func DefaultTextInputColors() TextInputColors {
 		Cursor:      color.Black,
 		Selection:   color.RGBA{100, 149, 237, 127}, // Dodger Blue
 		FocusBorder: color.Black,
+		Placeholder: color.RGBA{150, 150, 150, 255},
+		Error:       color.RGBA{220, 50, 50, 255},
 	}
 }
 
@@ -87,13 +134,22 @@ func WithTextInputColors(colors TextInputColors) ComponentOpt {
 			t.cursorColor = colors.Cursor
 			t.selectionColor = colors.Selection
 			t.focusBorderColor = colors.FocusBorder
+			if colors.Placeholder != nil {
+				t.placeholderColor = colors.Placeholder
+			}
+			if colors.Error != nil {
+				t.errorColor = colors.Error
+			}
 		}
 	}
 }
 
 func WithInitialText(text string) ComponentOpt {
 	return func(c Component) {
-		if t, ok := c.(*TextInput); ok {
+		switch t := c.(type) {
+		case *TextInput:
+			t.SetText(text)
+		case *TextArea:
 			t.SetText(text)
 		}
 	}
@@ -101,7 +157,10 @@ func WithInitialText(text string) ComponentOpt {
 
 func WithChangeHandler(handler func(string)) ComponentOpt {
 	return func(c Component) {
-		if t, ok := c.(*TextInput); ok {
+		switch t := c.(type) {
+		case *TextInput:
+			t.onChange = handler
+		case *TextArea:
 			t.onChange = handler
 		}
 	}
@@ -109,7 +168,10 @@ func WithChangeHandler(handler func(string)) ComponentOpt {
 
 func WithSubmitHandler(handler func(string)) ComponentOpt {
 	return func(c Component) {
-		if t, ok := c.(*TextInput); ok {
+		switch t := c.(type) {
+		case *TextInput:
+			t.onSubmit = handler
+		case *TextArea:
 			t.onSubmit = handler
 		}
 	}
@@ -123,6 +185,54 @@ func WithPasswordMasking() ComponentOpt {
 	}
 }
 
+// WithUndoLimit sets the maximum number of undo steps kept by the text input
+func WithUndoLimit(limit int) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextInput); ok {
+			t.undoLimit = limit
+		}
+	}
+}
+
+// WithMaxLength limits the number of characters that can be typed or pasted into the text input.
+// A limit of 0 means no limit. SetText is not limited.
+func WithMaxLength(length int) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextInput); ok {
+			t.maxLength = length
+		}
+	}
+}
+
+// WithInputFilter restricts typed and pasted characters to those accepted by the filter
+func WithInputFilter(filter InputFilter) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextInput); ok {
+			t.filter = filter
+		}
+	}
+}
+
+// WithValidator sets a function that validates the text whenever it changes.
+// A non-nil error marks the input as invalid and its message is shown below the input.
+// The message is drawn outside the input's bounds, one line of text below it, so the layout must leave room for it.
+func WithValidator(validator func(string) error) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextInput); ok {
+			t.validator = validator
+		}
+	}
+}
+
+// WithPlaceholder sets the hint text drawn while the text input is empty
+func WithPlaceholder(placeholder string) ComponentOpt {
+	return func(c Component) {
+		if t, ok := c.(*TextInput); ok {
+			t.placeholder = placeholder
+		}
+	}
+}
+
 func WithTabIndex(index int) ComponentOpt {
 	return func(c Component) {
 		if t, ok := c.(*TextInput); ok {
@@ -134,32 +244,45 @@ func WithTabIndex(index int) ComponentOpt {
 func NewTextInput(opts ...ComponentOpt) *TextInput {
 	colors := DefaultTextInputColors()
 	t := &TextInput{
-		BaseFocusable:   NewBaseFocusable(),
-		BaseContainer:   NewBaseContainer(opts...),
-		text:            make([]rune, 0),
-		cursorPos:       0,
-		selectionStart:  -1,
-		selectionEnd:    -1,
-		scrollOffset:    0,
-		repeatKey:       -1,
-		font:            basicfont.Face7x13,
-		textColor:       colors.Text,
-		backgroundColor: colors.Background,
-		cursorColor:     colors.Cursor,
-		selectionColor:  colors.Selection,
-		lastBlink:       time.Now(),
-		onChange:        func(string) {},
-		onSubmit:        func(string) {},
-		isPassword:      false,
-		maskChar:        '*', // Default mask character
-		focusable:       true,
-		tabIndex:        0,
+		BaseFocusable:    NewBaseFocusable(),
+		BaseContainer:    NewBaseContainer(opts...),
+		text:             make([]rune, 0),
+		cursorPos:        0,
+		selectionStart:   -1,
+		selectionEnd:     -1,
+		scrollOffset:     0,
+		repeatKey:        -1,
+		font:             basicfont.Face7x13,
+		textColor:        colors.Text,
+		backgroundColor:  colors.Background,
+		cursorColor:      colors.Cursor,
+		selectionColor:   colors.Selection,
+		focusBorderColor: colors.FocusBorder,
+		placeholderColor: colors.Placeholder,
+		errorColor:       colors.Error,
+		lastBlink:        time.Now(),
+		onChange:         func(string) {},
+		onSubmit:         func(string) {},
+		isPassword:       false,
+		maskChar:         '*', // Default mask character
+		focusable:        true,
+		tabIndex:         0,
+		undoLimit:        100,
 	}
 
 	for _, opt := range opts {
 		opt(t)
 	}
 
+	// Initial text set through options is not an undoable edit
+	t.ClearHistory()
+
+	// Validate initial text, but don't flag an empty field before the user has typed anything
+	t.validationErr = nil
+	if len(t.text) > 0 {
+		t.Validate()
+	}
+
 	t.registerEventListeners()
 	return t
 }
@@ -167,6 +290,7 @@ func NewTextInput(opts ...ComponentOpt) *TextInput {
 func (t *TextInput) registerEventListeners() {
 	t.AddEventListener(MouseDown, func(e *Event) {
 		t.Focus()
+		t.endEditGroup()
 
 		// Calculate cursor position from click, accounting for scroll
 		clickX := e.MouseX - t.GetAbsolutePosition().X + t.scrollOffset
@@ -188,7 +312,7 @@ func (t *TextInput) registerEventListeners() {
 	})
 
 	t.AddEventListener(Drag, func(e *Event) {
-		if t.isFocused {
+		if t.isFocused && e.MouseButton == ebiten.MouseButtonLeft {
 			clickX := e.MouseX - t.GetAbsolutePosition().X + t.scrollOffset
 			t.selectionEnd = t.getCharIndexAtX(clickX)
 			t.cursorPos = t.selectionEnd
@@ -226,27 +350,17 @@ func (t *TextInput) handleKeyboardInput() {
 
 func (t *TextInput) handleCharacterInput() {
 	inputChars := ebiten.AppendInputChars(nil)
-	if len(inputChars) > 0 {
-		if t.hasSelection() {
-			t.deleteSelection()
-		}
-
-		for _, ch := range inputChars {
-			if unicode.IsPrint(ch) {
-				newText := make([]rune, len(t.text)+1)
-				copy(newText, t.text[:t.cursorPos])
-				newText[t.cursorPos] = ch
-				copy(newText[t.cursorPos+1:], t.text[t.cursorPos:])
-				t.text = newText
-				t.cursorPos++
-				t.ensureCursorVisible()
-
-				if t.onChange != nil {
-					t.onChange(string(t.text))
-				}
-			}
+	accepted := inputChars[:0]
+	for _, ch := range inputChars {
+		if t.acceptsRune(ch) {
+			accepted = append(accepted, ch)
 		}
 	}
+	if len(accepted) == 0 {
+		return
+	}
+
+	t.replaceSelection(accepted, textEditTyping)
 }
 
 func (t *TextInput) handleSpecialKeys() bool {
@@ -259,6 +373,8 @@ func (t *TextInput) handleSpecialKeys() bool {
 		ebiten.KeyX,
 		ebiten.KeyC,
 		ebiten.KeyV,
+		ebiten.KeyZ,
+		ebiten.KeyY,
 		ebiten.KeyLeft,
 		ebiten.KeyRight,
 		ebiten.KeyBackspace,
@@ -309,6 +425,9 @@ func (t *TextInput) handleKeyRepeat() {
 		switch t.repeatKey {
 		case ebiten.KeyV:
 			t.handlePaste()
+		case ebiten.KeyZ, ebiten.KeyY:
+			// Allow holding undo/redo to step through history
+			t.handleKey(t.repeatKey, ctrlPressed, shiftPressed)
 		case ebiten.KeyLeft, ebiten.KeyRight:
 			// Allow ctrl+arrow keys to repeat for word-by-word movement
 			t.handleKey(t.repeatKey, ctrlPressed, shiftPressed)
@@ -335,6 +454,20 @@ func (t *TextInput) handleKey(key ebiten.Key, ctrlPressed, shiftPressed bool) bo
 			shortcuts[key]()
 			handled = true
 		}
+	case ebiten.KeyZ:
+		if ctrlPressed {
+			if shiftPressed {
+				t.Redo()
+			} else {
+				t.Undo()
+			}
+			handled = true
+		}
+	case ebiten.KeyY:
+		if ctrlPressed {
+			t.Redo()
+			handled = true
+		}
 	case ebiten.KeyLeft:
 		t.handleLeftKey(ctrlPressed, shiftPressed)
 		handled = true
@@ -367,6 +500,14 @@ func (t *TextInput) handleKey(key ebiten.Key, ctrlPressed, shiftPressed bool) bo
 	return handled
 }
 
+// GetCursorAt returns the text cursor unless another cursor was set with WithCursor
+func (t *TextInput) GetCursorAt(x, y float64) ebiten.CursorShapeType {
+	if cursor := t.GetCursor(); cursor != ebiten.CursorShapeDefault {
+		return cursor
+	}
+	return ebiten.CursorShapeText
+}
+
 func (t *TextInput) Draw(screen *ebiten.Image) {
 	if t.IsHidden() {
 		return
@@ -376,7 +517,13 @@ func (t *TextInput) Draw(screen *ebiten.Image) {
 	size := t.GetSize()
 	padding := t.GetPadding()
 
-	if t.isFocused {
+	if t.validationErr != nil {
+		// Invalid inputs always show the border, in the error color
+		errorBorder := GetCache().BorderImageWithColor(int(size.Width+2), int(size.Height+2), t.errorColor)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-1, pos.Y-1)
+		screen.DrawImage(errorBorder, op)
+	} else if t.isFocused {
 		// Draw the focus border 1px
 		focusBorder := GetCache().BorderImageWithColor(int(size.Width+2), int(size.Height+2), t.focusBorderColor)
 		op := &ebiten.DrawImageOptions{}
@@ -384,13 +531,19 @@ func (t *TextInput) Draw(screen *ebiten.Image) {
 		screen.DrawImage(focusBorder, op)
 	}
 
+	// Draw the container first so its background stays behind the text.
+	// A nine-slice background replaces the background color.
+	t.BaseContainer.Draw(screen)
+
 	// Draw background first on the main screen
-	bgWidth := int(size.Width - padding.Left - padding.Right)
-	bgHeight := int(size.Height - padding.Top - padding.Bottom)
-	bg := GetCache().ImageWithColor(bgWidth, bgHeight, t.backgroundColor)
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(pos.X+padding.Left, pos.Y+padding.Top)
-	screen.DrawImage(bg, op)
+	if t.GetBackgroundNineSlice() == nil {
+		bgWidth := int(size.Width - padding.Left - padding.Right)
+		bgHeight := int(size.Height - padding.Top - padding.Bottom)
+		bg := GetCache().ImageWithColor(bgWidth, bgHeight, t.backgroundColor)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X+padding.Left, pos.Y+padding.Top)
+		screen.DrawImage(bg, op)
+	}
 
 	// Create clip bounds for text content
 	clipBounds := image.Rect(
@@ -425,6 +578,15 @@ func (t *TextInput) Draw(screen *ebiten.Image) {
 			int(pos.Y+padding.Top+t.getTextBaseline()),
 			t.textColor,
 		)
+	} else if t.placeholder != "" {
+		text.Draw(
+			clippedScreen,
+			t.placeholder,
+			t.font,
+			int(pos.X+padding.Left),
+			int(pos.Y+padding.Top+t.getTextBaseline()),
+			t.placeholderColor,
+		)
 	}
 
 	// Draw cursor on the main screen (not clipped)
@@ -432,7 +594,17 @@ func (t *TextInput) Draw(screen *ebiten.Image) {
 		t.drawCursor(screen)
 	}
 
-	t.BaseContainer.Draw(screen)
+	// Draw the validation message just below the input, in the room the layout leaves for it
+	if t.validationErr != nil {
+		text.Draw(
+			screen,
+			t.validationErr.Error(),
+			t.font,
+			int(pos.X),
+			int(pos.Y+size.Height+2)+t.font.Metrics().Ascent.Ceil(),
+			t.errorColor,
+		)
+	}
 }
 
 func (t *TextInput) getTextBaseline() float64 {
@@ -573,6 +745,9 @@ func (t *TextInput) handleRightKey(ctrlPressed, shiftPressed bool) {
 }
 
 func (t *TextInput) updateSelection(prevPos int, shiftPressed bool) {
+	// Moving the cursor ends the current group of typed characters
+	t.endEditGroup()
+
 	if !shiftPressed {
 		t.ClearSelection()
 	} else {
@@ -597,24 +772,24 @@ func (t *TextInput) handleEnd(shiftPressed bool) {
 
 func (t *TextInput) handleBackspace() {
 	if t.hasSelection() {
+		t.recordUndo(textEditOther)
 		t.deleteSelection()
 	} else if t.cursorPos > 0 {
+		t.recordUndo(textEditDeleting)
 		t.text = append(t.text[:t.cursorPos-1], t.text[t.cursorPos:]...)
 		t.cursorPos--
-		if t.onChange != nil {
-			t.onChange(string(t.text))
-		}
+		t.notifyChange()
 	}
 }
 
 func (t *TextInput) handleDelete() {
 	if t.hasSelection() {
+		t.recordUndo(textEditOther)
 		t.deleteSelection()
 	} else if t.cursorPos < len(t.text) {
+		t.recordUndo(textEditDeleting)
 		t.text = append(t.text[:t.cursorPos], t.text[t.cursorPos+1:]...)
-		if t.onChange != nil {
-			t.onChange(string(t.text))
-		}
+		t.notifyChange()
 	}
 }
 
@@ -656,22 +831,24 @@ func (t *TextInput) deleteSelection() {
 	t.cursorPos = start
 	t.ClearSelection()
 
-	if t.onChange != nil {
-		t.onChange(string(t.text))
-	}
+	t.notifyChange()
 }
 
 // Public API methods
 
+// SetText replaces the text and moves the cursor to the end.
+// The text is set as given: it is not limited to the max length or checked against the input filter.
 func (t *TextInput) SetText(text string) {
+	if text != string(t.text) {
+		// Programmatic changes (such as clearing a submitted message) can be undone too
+		t.recordUndo(textEditOther)
+	}
 	t.text = []rune(text)
 	t.cursorPos = len(t.text)
 	t.ClearSelection()
 	t.scrollOffset = 0
 	t.ensureCursorVisible()
-	if t.onChange != nil {
-		t.onChange(text)
-	}
+	t.notifyChange()
 }
 
 func (t *TextInput) GetText() string {
@@ -730,6 +907,7 @@ func (t *TextInput) handleCut() {
 		return
 	}
 	t.handleCopy()
+	t.recordUndo(textEditOther)
 	t.deleteSelection()
 }
 
@@ -744,30 +922,18 @@ func (t *TextInput) handlePaste() {
 	}
 	text := string(bytes)
 
-	if t.hasSelection() {
-		t.deleteSelection()
-	}
-
-	// Filter out any newlines or tabs from the pasted text
+	// Filter out any newlines, tabs and rejected characters from the pasted text
 	filtered := []rune{}
 	for _, ch := range text {
-		if ch != '\n' && ch != '\r' && ch != '\t' {
+		if ch != '\n' && ch != '\r' && ch != '\t' && t.acceptsRune(ch) {
 			filtered = append(filtered, ch)
 		}
 	}
-
-	// Insert the filtered text at cursor position
-	newText := make([]rune, 0, len(t.text)+len(filtered))
-	newText = append(newText, t.text[:t.cursorPos]...)
-	newText = append(newText, filtered...)
-	newText = append(newText, t.text[t.cursorPos:]...)
-	t.text = newText
-	t.cursorPos += len(filtered)
-	t.ensureCursorVisible()
-
-	if t.onChange != nil {
-		t.onChange(string(t.text))
+	if len(filtered) == 0 {
+		return
 	}
+
+	t.replaceSelection(filtered, textEditOther)
 }
 
 func (t *TextInput) Select(start, end int) {
@@ -793,3 +959,180 @@ func (t *TextInput) ClearSelection() {
 	t.selectionStart = -1
 	t.selectionEnd = -1
 }
+
+// acceptsRune reports whether a character passes the printable check and the input filter
+func (t *TextInput) acceptsRune(r rune) bool {
+	if !unicode.IsPrint(r) {
+		return false
+	}
+	return t.filter == nil || t.filter(r)
+}
+
+// replaceSelection replaces the selection with runes, or inserts them at the cursor if nothing is selected.
+// Runes that don't fit within the max length are dropped. If the text would not change,
+// nothing is recorded for undo and the change handler is not called.
+func (t *TextInput) replaceSelection(runes []rune, kind textEditKind) {
+	start, end := t.cursorPos, t.cursorPos
+	if t.hasSelection() {
+		start, end = t.getOrderedSelection()
+	}
+
+	runes = t.truncateToMaxLength(runes, end-start)
+	if len(runes) == 0 && start == end {
+		return
+	}
+
+	t.recordUndo(kind)
+	t.text = append(t.text[:start], t.text[end:]...)
+	t.cursorPos = start
+	t.ClearSelection()
+	t.insertRunes(runes)
+	t.notifyChange()
+}
+
+// truncateToMaxLength trims runes so that inserting them, in place of the given number
+// of replaced runes, does not exceed the max length
+func (t *TextInput) truncateToMaxLength(runes []rune, replaced int) []rune {
+	if t.maxLength <= 0 {
+		return runes
+	}
+	remaining := t.maxLength - (len(t.text) - replaced)
+	if remaining <= 0 {
+		return nil
+	}
+	if len(runes) > remaining {
+		return runes[:remaining]
+	}
+	return runes
+}
+
+// insertRunes inserts runes at the cursor and moves the cursor past them
+func (t *TextInput) insertRunes(runes []rune) {
+	newText := make([]rune, 0, len(t.text)+len(runes))
+	newText = append(newText, t.text[:t.cursorPos]...)
+	newText = append(newText, runes...)
+	newText = append(newText, t.text[t.cursorPos:]...)
+	t.text = newText
+	t.cursorPos += len(runes)
+	t.ensureCursorVisible()
+}
+
+// notifyChange validates the text and calls the change handler
+func (t *TextInput) notifyChange() {
+	t.Validate()
+	if t.onChange != nil {
+		t.onChange(string(t.text))
+	}
+}
+
+// Validate runs the validator against the current text and returns whether it is valid
+func (t *TextInput) Validate() bool {
+	if t.validator == nil {
+		t.validationErr = nil
+		return true
+	}
+	t.validationErr = t.validator(string(t.text))
+	return t.validationErr == nil
+}
+
+// IsValid returns whether the text passed the last validation
+func (t *TextInput) IsValid() bool {
+	return t.validationErr == nil
+}
+
+// GetValidationError returns the error from the last validation, or nil if the text is valid
+func (t *TextInput) GetValidationError() error {
+	return t.validationErr
+}
+
+// SetPlaceholder sets the hint text drawn while the text input is empty
+func (t *TextInput) SetPlaceholder(placeholder string) {
+	t.placeholder = placeholder
+}
+
+// recordUndo saves the current state before an edit of the given kind.
+// Consecutive typing or deleting within a short time is merged into a single undo step.
+func (t *TextInput) recordUndo(kind textEditKind) {
+	now := time.Now()
+	coalesce := kind != textEditOther &&
+		kind == t.lastEdit &&
+		!t.hasSelection() &&
+		now.Sub(t.lastEditTime) < time.Second
+
+	t.lastEdit = kind
+	t.lastEditTime = now
+
+	if coalesce {
+		return
+	}
+
+	t.undoStack = append(t.undoStack, t.snapshot())
+	if t.undoLimit > 0 && len(t.undoStack) > t.undoLimit {
+		t.undoStack = t.undoStack[len(t.undoStack)-t.undoLimit:]
+	}
+	t.redoStack = nil
+}
+
+// endEditGroup prevents the next edit from being merged with the previous one
+func (t *TextInput) endEditGroup() {
+	t.lastEdit = textEditNone
+}
+
+func (t *TextInput) snapshot() textInputState {
+	return textInputState{
+		text:           append([]rune(nil), t.text...),
+		cursorPos:      t.cursorPos,
+		selectionStart: t.selectionStart,
+		selectionEnd:   t.selectionEnd,
+	}
+}
+
+func (t *TextInput) restore(state textInputState) {
+	t.text = append([]rune(nil), state.text...)
+	t.cursorPos = state.cursorPos
+	t.selectionStart = state.selectionStart
+	t.selectionEnd = state.selectionEnd
+	t.endEditGroup()
+	t.ensureCursorVisible()
+
+	t.notifyChange()
+}
+
+// Undo reverts the last edit
+func (t *TextInput) Undo() {
+	if !t.CanUndo() {
+		return
+	}
+	state := t.undoStack[len(t.undoStack)-1]
+	t.undoStack = t.undoStack[:len(t.undoStack)-1]
+	t.redoStack = append(t.redoStack, t.snapshot())
+	t.restore(state)
+}
+
+// Redo reapplies the last undone edit
+func (t *TextInput) Redo() {
+	if !t.CanRedo() {
+		return
+	}
+	state := t.redoStack[len(t.redoStack)-1]
+	t.redoStack = t.redoStack[:len(t.redoStack)-1]
+	t.undoStack = append(t.undoStack, t.snapshot())
+	t.restore(state)
+}
+
+// CanUndo returns whether there is an edit to undo
+func (t *TextInput) CanUndo() bool {
+	return len(t.undoStack) > 0
+}
+
+// CanRedo returns whether there is an undone edit to redo
+func (t *TextInput) CanRedo() bool {
+	return len(t.redoStack) > 0
+}
+
+// ClearHistory discards all undo and redo steps
+func (t *TextInput) ClearHistory() {
+	t.undoStack = nil
+	t.redoStack = nil
+	t.endEditGroup()
+}
@@ -0,0 +1,96 @@
+package ebui
+
+import "testing"
+
+func TestTextInputUndoCoalescing(t *testing.T) {
+	typeText := func(s string) func(*TextInput) {
+		return func(ti *TextInput) { ti.replaceSelection([]rune(s), textEditTyping) }
+	}
+	paste := func(s string) func(*TextInput) {
+		return func(ti *TextInput) { ti.replaceSelection([]rune(s), textEditOther) }
+	}
+	endGroup := func(ti *TextInput) { ti.endEditGroup() }
+
+	tests := []struct {
+		name      string
+		undoLimit int
+		edits     []func(*TextInput)
+		want      []string // Text after each successive Undo, until nothing is left to undo
+	}{
+		{
+			name:  "typing is merged into one step",
+			edits: []func(*TextInput){typeText("a"), typeText("b"), typeText("c")},
+			want:  []string{""},
+		},
+		{
+			name:  "a different kind of edit starts a new step",
+			edits: []func(*TextInput){typeText("a"), typeText("b"), paste("cd"), typeText("e")},
+			want:  []string{"abcd", "ab", ""},
+		},
+		{
+			name:  "other edits are never merged",
+			edits: []func(*TextInput){paste("a"), paste("b")},
+			want:  []string{"a", ""},
+		},
+		{
+			name:  "ending the group starts a new step",
+			edits: []func(*TextInput){typeText("a"), endGroup, typeText("b")},
+			want:  []string{"a", ""},
+		},
+		{
+			name:      "oldest steps are dropped past the limit",
+			undoLimit: 2,
+			edits:     []func(*TextInput){paste("a"), paste("b"), paste("c")},
+			want:      []string{"ab", "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := NewTextInput(WithSize(200, 30))
+			if tt.undoLimit > 0 {
+				ti.undoLimit = tt.undoLimit
+			}
+			for _, edit := range tt.edits {
+				edit(ti)
+			}
+			for i, want := range tt.want {
+				ti.Undo()
+				if got := ti.GetText(); got != want {
+					t.Fatalf("after undo %d: text = %q, want %q", i+1, got, want)
+				}
+			}
+			if ti.CanUndo() {
+				t.Errorf("CanUndo() = true after %d undos, want false", len(tt.want))
+			}
+		})
+	}
+}
+
+func TestTruncateToMaxLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxLength int
+		text      string
+		replaced  int
+		runes     string
+		want      string
+	}{
+		{name: "no limit", maxLength: 0, text: "abc", runes: "de", want: "de"},
+		{name: "fits", maxLength: 5, text: "abc", runes: "de", want: "de"},
+		{name: "trimmed to the room left", maxLength: 4, text: "abc", runes: "de", want: "d"},
+		{name: "full", maxLength: 3, text: "abc", runes: "d", want: ""},
+		{name: "replaced runes free room", maxLength: 3, text: "abc", replaced: 2, runes: "xyz", want: "xy"},
+		{name: "text already past the limit", maxLength: 2, text: "abcd", runes: "x", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := NewTextInput(WithSize(200, 30), WithMaxLength(tt.maxLength))
+			ti.text = []rune(tt.text)
+			if got := string(ti.truncateToMaxLength([]rune(tt.runes), tt.replaced)); got != tt.want {
+				t.Errorf("truncateToMaxLength(%q, %d) = %q, want %q", tt.runes, tt.replaced, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font/basicfont"
 )
 
 // TooltipPosition defines the preferred position of a tooltip relative to the mouse cursor
@@ -158,6 +159,21 @@ func NewTooltip(opts ...ComponentOpt) *Tooltip {
 	return tooltip
 }
 
+// NewTextTooltip creates a tooltip showing a single line of text, such as a hint for an icon-only button
+func NewTextTooltip(text string, opts ...ComponentOpt) *Tooltip {
+	tooltip := NewTooltip(opts...)
+
+	width := textBoundsWidth(basicfont.Face7x13, []rune(text))
+	height := float64(basicfont.Face7x13.Metrics().Height.Ceil())
+	tooltip.SetContent(NewLabel(
+		text,
+		WithSize(width+12, height+8),
+		WithJustify(JustifyCenter),
+	))
+
+	return tooltip
+}
+
 // Draw renders the tooltip
 func (t *Tooltip) Draw(screen *ebiten.Image) {
 	if t.IsHidden() {
@@ -167,8 +183,8 @@ func (t *Tooltip) Draw(screen *ebiten.Image) {
 	pos := t.GetAbsolutePosition()
 	size := t.GetSize()
 
-	// Draw border if border width > 0
-	if t.borderWidth > 0 {
+	// Draw border if border width > 0. Nine-slice backgrounds provide their own border.
+	if t.borderWidth > 0 && t.GetBackgroundNineSlice() == nil {
 		border := GetCache().BorderImageWithColor(
 			int(size.Width),
 			int(size.Height),
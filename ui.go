@@ -41,6 +41,7 @@ func (u *Manager) Update() error {
 
 func (u *Manager) Draw(screen *ebiten.Image) {
 	u.root.Draw(screen)
+	u.input.Draw(screen)
 }
 
 // DisableFocus disables focus management for the UI.
@@ -1,7 +1,60 @@
 package ebui
 
-import "math"
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
 
 func clamp(value, min, max float64) float64 {
 	return math.Min(math.Max(value, min), max)
 }
+
+// Direction represents the direction an indicator arrow points in
+type Direction int
+
+const (
+	DirectionUp Direction = iota
+	DirectionDown
+	DirectionLeft
+	DirectionRight
+)
+
+// drawArrow draws a small filled triangle centered at (cx, cy) pointing in the given direction
+func drawArrow(screen *ebiten.Image, cx, cy, size float64, dir Direction, col color.Color) {
+	half := int(size / 2)
+	if half <= 0 {
+		return
+	}
+
+	for i := 0; i < half; i++ {
+		// Each step away from the tip draws a slightly longer 1px line
+		length := 2*i + 1
+		offset := float64(i) - float64(half)/2
+
+		op := &ebiten.DrawImageOptions{}
+		switch dir {
+		case DirectionUp:
+			op.GeoM.Translate(cx-float64(i), cy+offset)
+			screen.DrawImage(GetCache().ImageWithColor(length, 1, col), op)
+		case DirectionDown:
+			op.GeoM.Translate(cx-float64(i), cy-offset)
+			screen.DrawImage(GetCache().ImageWithColor(length, 1, col), op)
+		case DirectionLeft:
+			op.GeoM.Translate(cx+offset, cy-float64(i))
+			screen.DrawImage(GetCache().ImageWithColor(1, length, col), op)
+		case DirectionRight:
+			op.GeoM.Translate(cx-offset, cy-float64(i))
+			screen.DrawImage(GetCache().ImageWithColor(1, length, col), op)
+		}
+	}
+}
+
+// Orientation represents the axis a component is laid out along
+type Orientation int
+
+const (
+	OrientationHorizontal Orientation = iota
+	OrientationVertical
+)
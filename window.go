@@ -2,6 +2,7 @@ package ebui
 
 import (
 	"image/color"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -11,6 +12,8 @@ type WindowState int
 const (
 	WindowStateHidden WindowState = iota
 	WindowStateNormal
+	WindowStateMinimized // Collapsed to the header
+	WindowStateMaximized // Filling the window manager
 )
 
 // WindowColors represents the color scheme for a window
@@ -37,16 +40,44 @@ func DefaultWindowColors() WindowColors {
 	}
 }
 
+// resizeEdge is a set of window edges being resized
+type resizeEdge int
+
+const (
+	resizeEdgeLeft resizeEdge = 1 << iota
+	resizeEdgeRight
+	resizeEdgeTop
+	resizeEdgeBottom
+)
+
+// WindowSkin holds nine-slice textures for the window header and content background
+type WindowSkin struct {
+	Header     *NineSlice
+	Background *NineSlice
+}
+
 type Window struct {
 	*BaseFocusable
 	*LayoutContainer
 	manager         *WindowManager
+	key             string
 	header          *BaseContainer
 	content         *LayoutContainer
 	title           string
 	titleLabel      *Label
 	closeButton     *Button
+	minimizeButton  *Button
+	maximizeButton  *Button
 	state           WindowState
+	shownState      WindowState // State to return to when a hidden window is shown
+	minimizedFrom   WindowState // State to return to when a minimized window is restored
+	normalPos       Position
+	normalSize      Size
+	isMinimizable   bool
+	isMaximizable   bool
+	lastHeaderClick time.Time
+	stateCallback   func(state WindowState)
+	headerIcons     [3]*ebiten.Image // Indexed by windowIcon
 	isDragging      bool
 	dragStartX      float64
 	dragStartY      float64
@@ -55,9 +86,25 @@ type Window struct {
 	headerHeight    float64
 	closeCallback   func()
 	colors          WindowColors
+	skin            WindowSkin
 	borderWidth     float64
 	isStatic        bool
 	closeButtonSize Size
+	isResizable     bool
+	minSize         Size
+	maxSize         Size
+	resizeEdges     resizeEdge
+	resizeStartX    float64
+	resizeStartY    float64
+	resizeStartPos  Position
+	resizeStartSize Size
+	resizeHandle    float64
+	movingWindow    *Window // Window moved by the current header drag, which may be a tab dragged out of this one
+	isSnapped       bool
+	preSnapSize     Size
+	isDockable      bool
+	dockGroup       *windowDockGroup
+	pressedTab      *Window
 }
 
 type WindowOpt func(w *Window)
@@ -69,6 +116,13 @@ func WithWindowTitle(title string) WindowOpt {
 	}
 }
 
+// WithWindowKey sets the key that identifies the window in saved layouts
+func WithWindowKey(key string) WindowOpt {
+	return func(w *Window) {
+		w.key = key
+	}
+}
+
 // WithCloseCallback sets the callback for when the window is closed
 func WithCloseCallback(callback func()) WindowOpt {
 	return func(w *Window) {
@@ -83,6 +137,14 @@ func WithWindowColors(colors WindowColors) WindowOpt {
 	}
 }
 
+// WithWindowSkin draws the window header and content with nine-slice textures.
+// The window border is not drawn when the content is skinned.
+func WithWindowSkin(skin WindowSkin) WindowOpt {
+	return func(w *Window) {
+		w.skin = skin
+	}
+}
+
 // WithBorderWidth sets the width of the window border
 func WithBorderWidth(width float64) WindowOpt {
 	return func(w *Window) {
@@ -118,22 +180,96 @@ func WithCloseButtonSize(size Size) WindowOpt {
 	}
 }
 
-// Show makes the window visible
+// WithMinimizable adds a header button that collapses the window to its header
+func WithMinimizable() WindowOpt {
+	return func(w *Window) {
+		w.isMinimizable = true
+	}
+}
+
+// WithMaximizable adds a header button that makes the window fill the window manager.
+// Double-clicking the header also toggles between maximized and normal.
+func WithMaximizable() WindowOpt {
+	return func(w *Window) {
+		w.isMaximizable = true
+	}
+}
+
+// WithStateChangeCallback sets the callback for when the window is hidden, shown, minimized, maximized or restored
+func WithStateChangeCallback(callback func(state WindowState)) WindowOpt {
+	return func(w *Window) {
+		w.stateCallback = callback
+	}
+}
+
+// WithResizable lets the window be resized by dragging its edges and corners
+func WithResizable() WindowOpt {
+	return func(w *Window) {
+		w.isResizable = true
+	}
+}
+
+// WithMinWindowSize sets the smallest size a resizable window can be resized to
+func WithMinWindowSize(width, height float64) WindowOpt {
+	return func(w *Window) {
+		w.minSize = Size{Width: width, Height: height}
+	}
+}
+
+// WithMaxWindowSize sets the largest size a resizable window can be resized to.
+// A zero width or height leaves that dimension limited only by the window manager bounds.
+func WithMaxWindowSize(width, height float64) WindowOpt {
+	return func(w *Window) {
+		w.maxSize = Size{Width: width, Height: height}
+	}
+}
+
+// WithResizeHandleSize sets the width of the band along the window edges that resizes the window
+func WithResizeHandleSize(size float64) WindowOpt {
+	return func(w *Window) {
+		w.resizeHandle = size
+	}
+}
+
+// Show makes the window visible, in the state it was in when it was hidden.
+// Showing a window docked with others switches to its tab.
 func (w *Window) Show() {
-	w.state = WindowStateNormal
+	if g := w.dockGroup; g != nil && g.active != w {
+		g.activate(w)
+		return
+	}
+	if w.state == WindowStateHidden {
+		w.setState(w.shownState)
+	}
 	w.manager.SetActiveWindow(w)
 	w.Enable()
 }
 
-// Hide makes the window invisible
+// Hide closes the window, making it invisible and calling the close callback.
+// A window docked with others is undocked first.
 func (w *Window) Hide() {
-	w.state = WindowStateHidden
-	w.Disable()
+	w.hide()
 	if w.closeCallback != nil {
 		w.closeCallback()
 	}
 }
 
+// hide makes the window invisible without calling the close callback,
+// for windows hidden by the application rather than closed
+func (w *Window) hide() {
+	w.Undock()
+	if w.state != WindowStateHidden {
+		w.shownState = w.state
+	}
+	w.setState(WindowStateHidden)
+	w.Disable()
+	w.manager.removeModal(w)
+	// The window in front of the others takes over from a hidden active window
+	if w.manager.activeWindow == w {
+		w.manager.setActiveWindow(w.manager.getTopWindow())
+	}
+}
+
 // Toggle shows the window if hidden, hides it if visible
 func (w *Window) Toggle() {
 	if w.IsVisible() {
@@ -153,35 +289,306 @@ func (w *Window) RemoveChild(child Component) {
 
 // IsVisible returns whether the window is currently visible
 func (w *Window) IsVisible() bool {
-	return w.state == WindowStateNormal
+	return w.state != WindowStateHidden
+}
+
+// GetState returns the state of the window
+func (w *Window) GetState() WindowState {
+	return w.state
+}
+
+// IsMinimized returns whether the window is collapsed to its header
+func (w *Window) IsMinimized() bool {
+	return w.state == WindowStateMinimized
+}
+
+// IsMaximized returns whether the window fills the window manager
+func (w *Window) IsMaximized() bool {
+	return w.state == WindowStateMaximized
+}
+
+// Minimize collapses the window to its header
+func (w *Window) Minimize() {
+	if w.state != WindowStateNormal && w.state != WindowStateMaximized {
+		return
+	}
+	if w.state == WindowStateNormal {
+		w.saveNormalBounds()
+	}
+	w.minimizedFrom = w.state
+
+	// Hidden content would still receive input, so it is disabled too
+	w.content.Hide()
+	w.content.Disable()
+	w.SetSize(Size{Width: w.GetSize().Width, Height: w.headerHeight})
+	w.setState(WindowStateMinimized)
+}
+
+// Maximize makes the window fill the window manager. Restore returns it to its previous bounds.
+func (w *Window) Maximize() {
+	if w.state != WindowStateNormal && w.state != WindowStateMinimized {
+		return
+	}
+	if w.state == WindowStateNormal {
+		w.saveNormalBounds()
+	}
+
+	w.content.Show()
+	w.content.Enable()
+	w.fillManager()
+	w.setState(WindowStateMaximized)
+}
+
+// Restore returns a minimized or maximized window to its previous state.
+// A minimized window that was maximized is maximized again, otherwise the window returns to its normal size.
+func (w *Window) Restore() {
+	switch w.state {
+	case WindowStateMinimized:
+		w.content.Show()
+		w.content.Enable()
+		if w.minimizedFrom == WindowStateMaximized {
+			w.fillManager()
+			w.setState(WindowStateMaximized)
+			return
+		}
+		// Minimized windows can be moved, so only the size is restored
+		w.SetSize(w.normalSize)
+		w.clampToScreen()
+		w.setState(WindowStateNormal)
+	case WindowStateMaximized:
+		pos := w.GetPosition()
+		pos.X, pos.Y = w.normalPos.X, w.normalPos.Y
+		w.SetPosition(pos)
+		w.SetSize(w.normalSize)
+		w.setState(WindowStateNormal)
+	}
+}
+
+// restoreNormal restores a minimized or maximized window to its normal bounds
+func (w *Window) restoreNormal() {
+	// A minimized window that was maximized needs restoring twice
+	for i := 0; i < 2 && (w.IsMinimized() || w.IsMaximized()); i++ {
+		w.Restore()
+	}
+}
+
+// ToggleMaximize maximizes the window, or restores it if it is maximized
+func (w *Window) ToggleMaximize() {
+	if w.state == WindowStateMaximized {
+		w.Restore()
+	} else {
+		w.Maximize()
+	}
+}
+
+// ToggleMinimize minimizes the window, or restores it if it is minimized
+func (w *Window) ToggleMinimize() {
+	if w.state == WindowStateMinimized {
+		w.Restore()
+	} else {
+		w.Minimize()
+	}
+}
+
+// GetNormalBounds returns the position and size the window has when it is neither minimized nor maximized
+func (w *Window) GetNormalBounds() (Position, Size) {
+	if w.state == WindowStateNormal {
+		return w.GetPosition(), w.GetSize()
+	}
+	return w.normalPos, w.normalSize
+}
+
+// SetStateChangeCallback sets the callback for when the window is hidden, shown, minimized, maximized or restored
+func (w *Window) SetStateChangeCallback(callback func(state WindowState)) {
+	w.stateCallback = callback
+}
+
+// setState changes the state of the window and notifies the state change callback
+func (w *Window) setState(state WindowState) {
+	if w.state == state {
+		return
+	}
+	w.state = state
+	w.updateHeaderButtons()
+	if w.stateCallback != nil {
+		w.stateCallback(state)
+	}
+}
+
+// saveNormalBounds remembers the bounds of a normal window to restore later
+func (w *Window) saveNormalBounds() {
+	w.normalPos = w.GetPosition()
+	w.normalSize = w.GetSize()
+}
+
+// fillManager sizes the window to fill the window manager
+func (w *Window) fillManager() {
+	pos := w.GetPosition()
+	pos.X, pos.Y = 0, 0
+	w.SetPosition(pos)
+	if size := w.manager.GetSize(); size != w.GetSize() {
+		w.SetSize(size)
+	}
 }
 
 func (w *Window) SetSize(size Size) {
 	w.LayoutContainer.SetSize(size)
 	// Update header and content sizes
 	w.header.SetSize(Size{Width: size.Width, Height: w.headerHeight})
+	w.titleLabel.SetSize(Size{Width: size.Width, Height: w.headerHeight})
 	w.content.SetSize(Size{Width: size.Width, Height: size.Height - w.headerHeight})
+	w.layoutHeaderButtons()
+}
+
+// layoutHeaderButtons places the minimize and maximize buttons at the right of the header
+func (w *Window) layoutHeaderButtons() {
+	x := w.GetSize().Width
+	for _, button := range []*Button{w.maximizeButton, w.minimizeButton} {
+		if button == nil {
+			continue
+		}
+		x -= button.GetSize().Width + 5
+		button.SetPosition(Position{
+			X:        x,
+			Y:        (w.headerHeight - button.GetSize().Height) / 2,
+			Relative: true,
+		})
+	}
+}
+
+// updateHeaderButtons shows the restore icon on the buttons of a minimized or maximized window
+func (w *Window) updateHeaderButtons() {
+	if w.minimizeButton != nil {
+		icon := windowIconMinimize
+		if w.state == WindowStateMinimized {
+			icon = windowIconRestore
+		}
+		w.minimizeButton.SetIcons(ButtonIcons{Default: w.headerIcons[icon]})
+	}
+	if w.maximizeButton != nil {
+		icon := windowIconMaximize
+		if w.state == WindowStateMaximized {
+			icon = windowIconRestore
+		}
+		w.maximizeButton.SetIcons(ButtonIcons{Default: w.headerIcons[icon]})
+	}
+}
+
+// SetResizable sets whether the window can be resized by dragging its edges and corners
+func (w *Window) SetResizable(resizable bool) {
+	w.isResizable = resizable
+}
+
+// IsResizable returns whether the window can be resized by dragging its edges and corners
+func (w *Window) IsResizable() bool {
+	return w.isResizable
+}
+
+// SetMinSize sets the smallest size a resizable window can be resized to
+func (w *Window) SetMinSize(size Size) {
+	w.minSize = size
+}
+
+// GetMinSize returns the smallest size a resizable window can be resized to
+func (w *Window) GetMinSize() Size {
+	return w.minSize
+}
+
+// SetMaxSize sets the largest size a resizable window can be resized to.
+// A zero width or height leaves that dimension limited only by the window manager bounds.
+func (w *Window) SetMaxSize(size Size) {
+	w.maxSize = size
+}
+
+// GetMaxSize returns the largest size a resizable window can be resized to
+func (w *Window) GetMaxSize() Size {
+	return w.maxSize
+}
+
+// IsResizing returns whether the window is being resized
+func (w *Window) IsResizing() bool {
+	return w.resizeEdges != 0
+}
+
+// GetCursorAt returns a resize cursor over the edges and corners of a resizable window
+func (w *Window) GetCursorAt(x, y float64) ebiten.CursorShapeType {
+	if shape, ok := w.getPriorityCursorAt(x, y); ok {
+		return shape
+	}
+	return w.GetCursor()
+}
+
+// getPriorityCursorAt returns the resize cursor over the edges and corners of a resizable window.
+// Pressing an edge resizes the window even where a component lies along it, so the cursor shows that too.
+func (w *Window) getPriorityCursorAt(x, y float64) (ebiten.CursorShapeType, bool) {
+	edges := w.resizeEdges
+	if edges == 0 {
+		edges = w.getResizeEdgesAt(x, y)
+	}
+
+	switch edges {
+	case resizeEdgeLeft, resizeEdgeRight:
+		return ebiten.CursorShapeEWResize, true
+	case resizeEdgeTop, resizeEdgeBottom:
+		return ebiten.CursorShapeNSResize, true
+	case resizeEdgeTop | resizeEdgeLeft, resizeEdgeBottom | resizeEdgeRight:
+		return ebiten.CursorShapeNWSEResize, true
+	case resizeEdgeTop | resizeEdgeRight, resizeEdgeBottom | resizeEdgeLeft:
+		return ebiten.CursorShapeNESWResize, true
+	}
+	return ebiten.CursorShapeDefault, false
 }
 
 func (w *Window) SetTitle(title string) {
 	w.title = title
-	w.titleLabel.SetText(title)
+	w.updateTitle()
+}
+
+// GetTitle returns the window title
+func (w *Window) GetTitle() string {
+	return w.title
+}
+
+// SetKey sets the key that identifies the window in saved layouts
+func (w *Window) SetKey(key string) {
+	w.key = key
+}
+
+// GetKey returns the key that identifies the window in saved layouts
+func (w *Window) GetKey() string {
+	return w.key
+}
+
+// updateTitle shows the title in the header, unless the header shows the tabs of docked windows
+func (w *Window) updateTitle() {
+	if w.hasTabs() {
+		w.titleLabel.SetText("")
+	} else {
+		w.titleLabel.SetText(w.title)
+	}
 }
 
 func (w *Window) Draw(screen *ebiten.Image) {
-	if !w.IsVisible() {
+	// Windows docked behind another window's tab are hidden
+	if !w.IsVisible() || w.IsHidden() {
 		return
 	}
 
 	// Draw the window border 1px
-	pos := w.GetAbsolutePosition()
-	size := w.GetSize()
-	bg := GetCache().ImageWithColor(int(size.Width+2), int(size.Height+2), w.colors.Border)
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(pos.X-1, pos.Y-1)
-	screen.DrawImage(bg, op)
+	if w.skin.Background == nil {
+		pos := w.GetAbsolutePosition()
+		size := w.GetSize()
+		bg := GetCache().ImageWithColor(int(size.Width+2), int(size.Height+2), w.colors.Border)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-1, pos.Y-1)
+		screen.DrawImage(bg, op)
+	}
 
 	w.LayoutContainer.Draw(screen)
+
+	if w.hasTabs() {
+		w.drawTabs(screen)
+	}
 }
 
 func (w *Window) clampToScreen() {
@@ -204,24 +611,94 @@ func (w *Window) clampToScreen() {
 }
 
 func (w *Window) registerEventListeners() {
-	w.AddEventListener(DragStart, func(e *Event) {
+	w.AddEventListener(MouseDown, func(e *Event) {
 		// Always activate window on any mouse down within the window
 		w.manager.SetActiveWindow(w)
 
-		// Don't drag if window is static
-		if w.isStatic {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+
+		// Double-clicking the header toggles maximize
+		if w.isMaximizable && e.Target == w && w.isOverHeader(e.MouseX, e.MouseY) && w.getResizeEdgesAt(e.MouseX, e.MouseY) == 0 {
+			now := time.Now()
+			if now.Sub(w.lastHeaderClick) <= doubleClickInterval {
+				w.lastHeaderClick = time.Time{}
+				w.ToggleMaximize()
+				return
+			}
+			w.lastHeaderClick = now
+		}
+
+		// Clicking a tab switches to it when the button is released, dragging it undocks it
+		if e.Target == w {
+			w.pressedTab = w.getTabAt(e.MouseX, e.MouseY)
+		}
+
+		// Resize from the edges, which take precedence over the components along them.
+		// The pointer is captured so the resize follows it outside the window.
+		if edges := w.getResizeEdgesAt(e.MouseX, e.MouseY); edges != 0 {
+			w.resizeEdges = edges
+			w.resizeStartX = e.MouseX
+			w.resizeStartY = e.MouseY
+			w.resizeStartPos = w.GetPosition()
+			w.resizeStartSize = w.GetSize()
+			e.SetPointerCapture(w)
+		}
+	})
+
+	w.AddEventListener(MouseMove, func(e *Event) {
+		if w.resizeEdges != 0 && e.Target == w {
+			w.resize(e.MouseX-w.resizeStartX, e.MouseY-w.resizeStartY)
+		}
+	})
+
+	w.AddEventListener(MouseUp, func(e *Event) {
+		if e.MouseButton != ebiten.MouseButtonLeft {
+			return
+		}
+		w.resizeEdges = 0
+
+		if tab := w.pressedTab; tab != nil && tab == w.getTabAt(e.MouseX, e.MouseY) {
+			w.dockGroup.activate(tab)
+		}
+		w.pressedTab = nil
+	})
+
+	w.AddEventListener(LostPointerCapture, func(e *Event) {
+		w.resizeEdges = 0
+	})
+
+	w.AddEventListener(DragStart, func(e *Event) {
+		// Don't drag if window is static, maximized or being resized
+		if w.isStatic || w.state == WindowStateMaximized || w.resizeEdges != 0 || e.MouseButton != ebiten.MouseButtonLeft {
 			return
 		}
 
 		// Start dragging only if over header
-		if w.isOverHeader(e.MouseX, e.MouseY) {
-			w.isDragging = true
-			w.dragStartX = e.MouseX
-			w.dragStartY = e.MouseY
-			absPos := w.GetAbsolutePosition()
-			w.windowStartX = absPos.X
-			w.windowStartY = absPos.Y
+		if !w.isOverHeader(e.MouseX, e.MouseY) {
+			return
 		}
+
+		// Dragging a tab moves its window out of the dock group
+		mover := w
+		if tab := w.getTabAt(e.MouseX, e.MouseY); tab != nil {
+			mover = tab
+			mover.Undock()
+			w.manager.SetActiveWindow(mover)
+		}
+		w.pressedTab = nil
+
+		// Dragging a snapped window away gives it back its size from before it was snapped
+		mover.unsnap(e.MouseX)
+
+		w.movingWindow = mover
+		w.isDragging = true
+		w.dragStartX = e.MouseX
+		w.dragStartY = e.MouseY
+		absPos := mover.GetAbsolutePosition()
+		w.windowStartX = absPos.X
+		w.windowStartY = absPos.Y
 	})
 
 	w.AddEventListener(DragEnd, func(e *Event) {
@@ -229,7 +706,14 @@ func (w *Window) registerEventListeners() {
 			return
 		}
 
+		// A drag cancelled with Esc neither docks nor snaps the window
+		if w.isDragging && e.Cancelled {
+			w.manager.cancelSnap()
+		} else if w.isDragging {
+			w.manager.finishSnap(w.movingWindow)
+		}
 		w.isDragging = false
+		w.movingWindow = nil
 	})
 
 	w.AddEventListener(Drag, func(e *Event) {
@@ -238,28 +722,111 @@ func (w *Window) registerEventListeners() {
 		}
 
 		if w.isDragging {
+			mover := w.movingWindow
 			deltaX := e.MouseX - w.dragStartX
 			deltaY := e.MouseY - w.dragStartY
-			newPos := w.GetPosition()
+			newPos := mover.GetPosition()
 			newPos.X = w.windowStartX + deltaX
 			newPos.Y = w.windowStartY + deltaY
-			w.SetPosition(newPos)
-			w.clampToScreen() // Clamp after setting new position
+			mover.SetPosition(newPos)
+			mover.clampToScreen() // Clamp after setting new position
+			w.manager.updateSnap(mover, e.MouseX, e.MouseY)
 		}
 	})
 }
 
+// getResizeEdgesAt returns the edges of a resizable window whose handles are under the given point
+func (w *Window) getResizeEdgesAt(x, y float64) resizeEdge {
+	if !w.isResizable || w.state != WindowStateNormal || !w.Contains(x, y) {
+		return 0
+	}
+
+	pos := w.GetAbsolutePosition()
+	size := w.GetSize()
+	// Corners are easier to grab than the edges alone
+	corner := w.resizeHandle * 2
+
+	var edges resizeEdge
+	if x < pos.X+w.resizeHandle {
+		edges |= resizeEdgeLeft
+	} else if x >= pos.X+size.Width-w.resizeHandle {
+		edges |= resizeEdgeRight
+	}
+	if y < pos.Y+w.resizeHandle {
+		edges |= resizeEdgeTop
+	} else if y >= pos.Y+size.Height-w.resizeHandle {
+		edges |= resizeEdgeBottom
+	}
+
+	if edges == resizeEdgeLeft || edges == resizeEdgeRight {
+		if y < pos.Y+corner {
+			edges |= resizeEdgeTop
+		} else if y >= pos.Y+size.Height-corner {
+			edges |= resizeEdgeBottom
+		}
+	} else if edges == resizeEdgeTop || edges == resizeEdgeBottom {
+		if x < pos.X+corner {
+			edges |= resizeEdgeLeft
+		} else if x >= pos.X+size.Width-corner {
+			edges |= resizeEdgeRight
+		}
+	}
+	return edges
+}
+
+// resize resizes the window by the pointer movement since the resize started.
+// The size is kept within the min and max sizes and the window manager bounds,
+// and the edges opposite the dragged ones stay in place.
+func (w *Window) resize(deltaX, deltaY float64) {
+	bounds := w.manager.GetSize()
+	start := w.resizeStartPos
+	pos := w.GetPosition()
+	size := w.resizeStartSize
+
+	minWidth := w.minSize.Width
+	minHeight := max(w.minSize.Height, w.headerHeight)
+
+	if w.resizeEdges&resizeEdgeRight != 0 {
+		maxWidth := bounds.Width - start.X
+		size.Width = clamp(size.Width+deltaX, minWidth, w.limitSize(maxWidth, w.maxSize.Width))
+	} else if w.resizeEdges&resizeEdgeLeft != 0 {
+		right := start.X + size.Width
+		maxWidth := right - min(start.X, 0)
+		size.Width = clamp(size.Width-deltaX, minWidth, w.limitSize(maxWidth, w.maxSize.Width))
+		pos.X = right - size.Width
+	}
+
+	if w.resizeEdges&resizeEdgeBottom != 0 {
+		maxHeight := bounds.Height - start.Y
+		size.Height = clamp(size.Height+deltaY, minHeight, w.limitSize(maxHeight, w.maxSize.Height))
+	} else if w.resizeEdges&resizeEdgeTop != 0 {
+		bottom := start.Y + size.Height
+		// The header can't be dragged above the window manager
+		size.Height = clamp(size.Height-deltaY, minHeight, w.limitSize(bottom, w.maxSize.Height))
+		pos.Y = bottom - size.Height
+	}
+
+	w.SetPosition(pos)
+	w.SetSize(size)
+	w.isSnapped = false
+}
+
+// limitSize returns the smaller of a bound and a maximum size, where a zero maximum means no maximum
+func (w *Window) limitSize(bound, maxSize float64) float64 {
+	if maxSize > 0 {
+		return min(bound, maxSize)
+	}
+	return bound
+}
+
 func (w *Window) isOverHeader(x, y float64) bool {
 	absPos := w.GetAbsolutePosition()
 
-	// Get position of close button
-	cbPos := w.closeButton.GetAbsolutePosition()
-	cbSize := w.closeButton.GetSize()
-
-	// Skip dragging if over close button
-	if x >= cbPos.X && x <= cbPos.X+cbSize.Width &&
-		y >= cbPos.Y && y <= cbPos.Y+cbSize.Height {
-		return false
+	// Skip dragging if over a header button
+	for _, button := range []*Button{w.closeButton, w.minimizeButton, w.maximizeButton} {
+		if button != nil && button.Contains(x, y) {
+			return false
+		}
 	}
 
 	return x >= absPos.X &&
@@ -268,20 +835,74 @@ func (w *Window) isOverHeader(x, y float64) bool {
 		y <= absPos.Y+w.headerHeight
 }
 
+var _ InputTrap = &WindowManager{}
+var _ EscapeHandler = &WindowManager{}
+
 type WindowManager struct {
 	*ZIndexedContainer
-	activeWindow *Window
-	nextZIndex   int
+	activeWindow     *Window
+	nextZIndex       int
+	modals           []*Window // Open modal windows, the last one is on top
+	backdrop         *BaseComponent
+	backdropColor    color.Color
+	snapThreshold    float64
+	snapZones        bool
+	snapPreviewColor color.Color
+	snapZone         snapZone
+	dockTarget       *Window
+	activeCallback   func(active, previous *Window)
+	cycleModifier    ebiten.Key
+	cycleKey         ebiten.Key
+	cycleEnabled     bool
+	cycleOrder       []*Window // Windows being cycled through, in the order they were last active
+	cycleIndex       int
+}
+
+// WithModalBackdropColor sets the color drawn over the windows behind a modal window
+func WithModalBackdropColor(color color.Color) ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.backdropColor = color
+		}
+	}
 }
 
 func NewWindowManager(opts ...ComponentOpt) *WindowManager {
 	wm := &WindowManager{
 		ZIndexedContainer: NewZIndexedContainer(opts...),
 		nextZIndex:        1,
+		backdropColor:     color.RGBA{0, 0, 0, 120},
+		snapThreshold:     8,
+		snapPreviewColor:  color.RGBA{70, 130, 220, 255},
+		cycleModifier:     ebiten.KeyControl,
+		cycleKey:          ebiten.KeyBackquote,
+		cycleEnabled:      true,
 	}
+
+	for _, opt := range opts {
+		opt(wm)
+	}
+
 	return wm
 }
 
+func (wm *WindowManager) Update() error {
+	if wm.backdrop != nil {
+		wm.backdrop.SetSize(wm.GetSize())
+	}
+
+	wm.handleWindowCycling()
+
+	// Maximized windows follow the size of the window manager
+	for _, child := range wm.GetChildren() {
+		if window, ok := child.(*Window); ok && window.IsMaximized() {
+			window.fillManager()
+		}
+	}
+
+	return wm.ZIndexedContainer.Update()
+}
+
 func (wm *WindowManager) CreateWindow(width, height float64, opts ...WindowOpt) *Window {
 	window := &Window{
 		BaseFocusable: NewBaseFocusable(),
@@ -294,7 +915,10 @@ func (wm *WindowManager) CreateWindow(width, height float64, opts ...WindowOpt)
 		colors:          DefaultWindowColors(),
 		borderWidth:     1,
 		state:           WindowStateNormal,
+		shownState:      WindowStateNormal,
 		closeButtonSize: Size{Width: 20, Height: 20},
+		minSize:         Size{Width: 100, Height: 60},
+		resizeHandle:    5,
 	}
 
 	for _, opt := range opts {
@@ -378,6 +1002,35 @@ func (wm *WindowManager) CreateWindow(width, height float64, opts ...WindowOpt)
 	)
 	window.header.AddChild(window.closeButton)
 
+	// Minimize and maximize buttons share the close button's look, with drawn icons
+	if window.isMinimizable || window.isMaximizable {
+		for _, icon := range []windowIcon{windowIconMinimize, windowIconMaximize, windowIconRestore} {
+			window.headerIcons[icon] = newWindowIcon(icon, closeCrossColor)
+		}
+	}
+	newHeaderButton := func(onClick func()) *Button {
+		button := NewButton(
+			WithSize(window.closeButtonSize.Width, window.closeButtonSize.Height),
+			WithButtonColors(ButtonColors{
+				Default:     closeButtonColor,
+				Hovered:     closeHoveredColor,
+				Pressed:     closePressedColor,
+				FocusBorder: color.Transparent,
+			}),
+			WithClickHandler(onClick),
+		)
+		window.header.AddChild(button)
+		return button
+	}
+	if window.isMinimizable {
+		window.minimizeButton = newHeaderButton(window.ToggleMinimize)
+	}
+	if window.isMaximizable {
+		window.maximizeButton = newHeaderButton(window.ToggleMaximize)
+	}
+	window.layoutHeaderButtons()
+	window.updateHeaderButtons()
+
 	// Create content container
 	window.content = NewLayoutContainer(
 		WithSize(width, height-window.headerHeight),
@@ -385,6 +1038,9 @@ func (wm *WindowManager) CreateWindow(width, height float64, opts ...WindowOpt)
 		WithLayout(NewVerticalStackLayout(0, AlignStart)),
 	)
 
+	window.header.SetBackgroundNineSlice(window.skin.Header)
+	window.content.SetBackgroundNineSlice(window.skin.Background)
+
 	window.LayoutContainer.AddChild(window.header)
 	window.LayoutContainer.AddChild(window.content)
 
@@ -399,7 +1055,13 @@ func (wm *WindowManager) CreateWindow(width, height float64, opts ...WindowOpt)
 	wm.nextZIndex++
 
 	wm.AddChild(window)
-	wm.SetActiveWindow(window)
+
+	// A window created while a modal is open stays behind the modal
+	if modal := wm.GetModal(); modal != nil {
+		wm.raiseModal(modal)
+	} else {
+		wm.SetActiveWindow(window)
+	}
 
 	return window
 }
@@ -409,7 +1071,11 @@ func (wm *WindowManager) SetActiveWindow(window *Window) {
 		return
 	}
 
-	wm.activeWindow = window
+	// While a modal is open, other windows cannot be brought in front of it
+	if modal := wm.GetModal(); modal != nil && window != modal {
+		return
+	}
+
 	maxZ := 0
 	for _, child := range wm.GetChildren() {
 		if z := child.GetPosition().ZIndex; z > maxZ {
@@ -420,4 +1086,138 @@ func (wm *WindowManager) SetActiveWindow(window *Window) {
 	pos.ZIndex = maxZ + 1
 	window.SetPosition(pos)
 	wm.nextZIndex = maxZ + 2
+	wm.setActiveWindow(window)
+}
+
+// ShowModal shows a window as a modal. The windows behind it are dimmed and, until it is
+// hidden, input and focus are confined to the modal. Pressing Esc hides the modal.
+// Modals can be stacked, in which case only the topmost one receives input.
+func (wm *WindowManager) ShowModal(window *Window) {
+	wm.removeModal(window)
+	wm.modals = append(wm.modals, window)
+
+	if window.state == WindowStateHidden {
+		window.setState(window.shownState)
+	}
+	if window.IsMinimized() {
+		window.Restore()
+	}
+	window.Enable()
+	wm.raiseModal(window)
+}
+
+// HandleEscape closes the topmost modal window. Esc presses used by an open menu or to cancel a drag don't reach it.
+func (wm *WindowManager) HandleEscape() bool {
+	modal := wm.GetModal()
+	if modal == nil {
+		return false
+	}
+	modal.Hide()
+	return true
+}
+
+// GetModal returns the topmost modal window, or nil if no modal is open
+func (wm *WindowManager) GetModal() *Window {
+	if len(wm.modals) == 0 {
+		return nil
+	}
+	return wm.modals[len(wm.modals)-1]
+}
+
+// IsModalOpen returns whether any modal window is open
+func (wm *WindowManager) IsModalOpen() bool {
+	return len(wm.modals) > 0
+}
+
+// GetInputTrap confines input to the topmost modal window
+func (wm *WindowManager) GetInputTrap() Component {
+	if modal := wm.GetModal(); modal != nil {
+		return modal
+	}
+	return nil
+}
+
+// raiseModal moves the backdrop above all windows and the modal above the backdrop
+func (wm *WindowManager) raiseModal(modal *Window) {
+	if wm.backdrop == nil {
+		wm.backdrop = NewBaseComponent(
+			WithSize(wm.GetSize().Width, wm.GetSize().Height),
+			WithBackground(wm.backdropColor),
+		)
+		wm.AddChild(wm.backdrop)
+	}
+
+	maxZ := 0
+	for _, child := range wm.GetChildren() {
+		if child == modal || child == wm.backdrop {
+			continue
+		}
+		if z := child.GetPosition().ZIndex; z > maxZ {
+			maxZ = z
+		}
+	}
+
+	wm.backdrop.SetPosition(Position{X: 0, Y: 0, ZIndex: maxZ + 1, Relative: true})
+	pos := modal.GetPosition()
+	pos.ZIndex = maxZ + 2
+	modal.SetPosition(pos)
+
+	wm.nextZIndex = maxZ + 3
+	wm.setActiveWindow(modal)
+}
+
+// removeModal removes a window from the modal stack, handing input back to the modal below it
+func (wm *WindowManager) removeModal(window *Window) {
+	for i, modal := range wm.modals {
+		if modal != window {
+			continue
+		}
+		wm.modals = append(wm.modals[:i], wm.modals[i+1:]...)
+
+		if next := wm.GetModal(); next != nil {
+			wm.raiseModal(next)
+		} else if wm.backdrop != nil {
+			wm.RemoveChild(wm.backdrop)
+			wm.backdrop = nil
+		}
+		return
+	}
+}
+
+// windowIcon is a glyph drawn on a window header button
+type windowIcon int
+
+const (
+	windowIconMinimize windowIcon = iota
+	windowIconMaximize
+	windowIconRestore
+)
+
+// newWindowIcon draws a 10x10 header button glyph
+func newWindowIcon(icon windowIcon, col color.Color) *ebiten.Image {
+	img := ebiten.NewImage(10, 10)
+	fill := func(x, y, width, height float64) {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(x, y)
+		img.DrawImage(GetCache().ImageWithColor(int(width), int(height), col), op)
+	}
+
+	switch icon {
+	case windowIconMinimize:
+		fill(1, 7, 8, 2)
+	case windowIconMaximize:
+		fill(0, 0, 10, 2)
+		fill(0, 0, 1, 10)
+		fill(9, 0, 1, 10)
+		fill(0, 9, 10, 1)
+	case windowIconRestore:
+		// A window in front of another
+		fill(3, 0, 7, 2)
+		fill(9, 0, 1, 7)
+		fill(0, 3, 7, 2)
+		fill(0, 3, 1, 7)
+		fill(6, 3, 1, 7)
+		fill(0, 9, 7, 1)
+	}
+	return img
 }
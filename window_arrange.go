@@ -0,0 +1,258 @@
+package ebui
+
+import (
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// WithActiveWindowCallback sets the callback for when the active window changes.
+// Either window may be nil, when no window was or is active.
+func WithActiveWindowCallback(callback func(active, previous *Window)) ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.activeCallback = callback
+		}
+	}
+}
+
+// WithWindowCycleKeys sets the keys that cycle through the visible windows. Holding the modifier and
+// pressing the key steps through the windows in the order they were last active, and Shift steps backwards.
+// The default is Ctrl+`. Alt+Tab is another common choice.
+func WithWindowCycleKeys(modifier, key ebiten.Key) ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.cycleModifier = modifier
+			wm.cycleKey = key
+		}
+	}
+}
+
+// WithWindowCycling enables or disables cycling through the windows with the keyboard. It is enabled by default.
+func WithWindowCycling(enabled bool) ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.cycleEnabled = enabled
+		}
+	}
+}
+
+// SetActiveWindowCallback sets the callback for when the active window changes
+func (wm *WindowManager) SetActiveWindowCallback(callback func(active, previous *Window)) {
+	wm.activeCallback = callback
+}
+
+// GetActiveWindow returns the active window, or nil if there is none
+func (wm *WindowManager) GetActiveWindow() *Window {
+	return wm.activeWindow
+}
+
+// setActiveWindow changes the active window, sending WindowDeactivated and WindowActivated
+// to the windows and notifying the active window callback
+func (wm *WindowManager) setActiveWindow(window *Window) {
+	previous := wm.activeWindow
+	if previous == window {
+		return
+	}
+	wm.activeWindow = window
+
+	now := time.Now().UnixNano()
+	if previous != nil {
+		event := &Event{Type: WindowDeactivated, Target: previous, Timestamp: now}
+		if window != nil {
+			event.RelatedTarget = window
+		}
+		previous.HandleEvent(event)
+	}
+	if window != nil {
+		event := &Event{Type: WindowActivated, Target: window, Timestamp: now}
+		if previous != nil {
+			event.RelatedTarget = previous
+		}
+		window.HandleEvent(event)
+	}
+
+	if wm.activeCallback != nil {
+		wm.activeCallback(window, previous)
+	}
+}
+
+// getVisibleWindows returns the windows that are shown, from back to front.
+// Docked windows whose tab is not selected are left out.
+func (wm *WindowManager) getVisibleWindows() []*Window {
+	var windows []*Window
+	for _, window := range wm.getWindows() {
+		if window.IsVisible() && !window.IsHidden() {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// getTopWindow returns the visible window in front of the others, or nil if no window is visible
+func (wm *WindowManager) getTopWindow() *Window {
+	windows := wm.getVisibleWindows()
+	if len(windows) == 0 {
+		return nil
+	}
+	return windows[len(windows)-1]
+}
+
+// CycleWindows activates the next visible window, or the previous one if reverse is true.
+// Windows are cycled in the order they were last active. While the cycle modifier key is held,
+// repeated calls step further through that order, so a single step switches back to the previously active window.
+// Windows can't be cycled while a modal is open.
+func (wm *WindowManager) CycleWindows(reverse bool) {
+	if wm.IsModalOpen() {
+		return
+	}
+
+	if wm.cycleOrder == nil {
+		// Activating a window brings it to the front, so the windows were last active in front to back order
+		windows := wm.getVisibleWindows()
+		for i := len(windows) - 1; i >= 0; i-- {
+			wm.cycleOrder = append(wm.cycleOrder, windows[i])
+		}
+		wm.cycleIndex = 0
+	}
+
+	count := len(wm.cycleOrder)
+	if count < 2 {
+		return
+	}
+	for range count {
+		if reverse {
+			wm.cycleIndex = (wm.cycleIndex - 1 + count) % count
+		} else {
+			wm.cycleIndex = (wm.cycleIndex + 1) % count
+		}
+		// Windows hidden since the cycle started are skipped
+		if window := wm.cycleOrder[wm.cycleIndex]; window.IsVisible() && !window.IsHidden() {
+			wm.SetActiveWindow(window)
+			return
+		}
+	}
+}
+
+// handleWindowCycling cycles through the windows when the cycle keys are pressed
+func (wm *WindowManager) handleWindowCycling() {
+	modifierPressed := ebiten.IsKeyPressed(wm.cycleModifier) ||
+		(wm.cycleModifier == ebiten.KeyControl && ebiten.IsKeyPressed(ebiten.KeyMeta))
+
+	// Releasing the modifier settles on the current window, so the next cycle starts from it
+	if !modifierPressed {
+		wm.cycleOrder = nil
+		return
+	}
+
+	if wm.cycleEnabled && inpututil.IsKeyJustPressed(wm.cycleKey) {
+		wm.CycleWindows(ebiten.IsKeyPressed(ebiten.KeyShift))
+	}
+}
+
+// getArrangeableWindows returns the windows that can be moved by CascadeWindows, from back to front
+func (wm *WindowManager) getArrangeableWindows() []*Window {
+	var windows []*Window
+	for _, window := range wm.getWindows() {
+		if wm.isArrangeable(window) {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// isArrangeable returns whether a window is shown and can be moved by CascadeWindows and TileWindows.
+// Static windows and modal windows stay where they are.
+func (wm *WindowManager) isArrangeable(window *Window) bool {
+	return window.IsVisible() && !window.IsHidden() && !window.isStatic && !wm.isModal(window)
+}
+
+// isModal returns whether a window is an open modal window
+func (wm *WindowManager) isModal(window *Window) bool {
+	for _, modal := range wm.modals {
+		if modal == window {
+			return true
+		}
+	}
+	return false
+}
+
+// CascadeWindows arranges the visible windows diagonally from the top left corner, each offset by its header height,
+// keeping their stacking order. Minimized and maximized windows are restored first, and windows that were
+// snapped or tiled get back their previous size. The cascade starts over at the top left when a window
+// would not fit in the window manager.
+func (wm *WindowManager) CascadeWindows() {
+	bounds := wm.GetSize()
+	x, y := 0.0, 0.0
+	for _, window := range wm.getArrangeableWindows() {
+		window.restoreNormal()
+		if window.isSnapped {
+			window.isSnapped = false
+			window.SetSize(window.preSnapSize)
+		}
+
+		size := window.GetSize()
+		if x+size.Width > bounds.Width || y+size.Height > bounds.Height {
+			x, y = 0, 0
+		}
+		window.moveTo(x, y)
+
+		x += window.headerHeight
+		y += window.headerHeight
+	}
+}
+
+// TileWindows arranges the visible windows in a grid that fills the window manager, in the order they were created.
+// Resizable windows are sized to fill their cell and get back their previous size when dragged away, like snapped windows.
+// Other windows are placed at the top left of their cell. Minimized and maximized windows are restored first.
+func (wm *WindowManager) TileWindows() {
+	var windows []*Window
+	// BaseContainer keeps the children in the order they were added
+	for _, child := range wm.BaseContainer.GetChildren() {
+		if window, ok := child.(*Window); ok && wm.isArrangeable(window) {
+			windows = append(windows, window)
+		}
+	}
+	if len(windows) == 0 {
+		return
+	}
+
+	bounds := wm.GetSize()
+	cols := int(math.Ceil(math.Sqrt(float64(len(windows)))))
+	rows := (len(windows) + cols - 1) / cols
+	cellHeight := bounds.Height / float64(rows)
+
+	for i, window := range windows {
+		row, col := i/cols, i%cols
+		// The cells of a partly filled last row are widened to fill it
+		rowCount := min(cols, len(windows)-row*cols)
+		cellWidth := bounds.Width / float64(rowCount)
+
+		window.restoreNormal()
+		pos := Position{X: float64(col) * cellWidth, Y: float64(row) * cellHeight}
+		if window.isResizable {
+			window.snapTo(pos, Size{Width: cellWidth, Height: cellHeight})
+		} else {
+			window.moveTo(pos.X, pos.Y)
+		}
+	}
+}
+
+// CloseAll hides every visible window, including modal windows
+func (wm *WindowManager) CloseAll() {
+	for _, window := range wm.getWindows() {
+		if window.IsVisible() {
+			window.Hide()
+		}
+	}
+}
+
+// moveTo moves the window, keeping it within the window manager bounds
+func (w *Window) moveTo(x, y float64) {
+	pos := w.GetPosition()
+	pos.X, pos.Y = x, y
+	w.SetPosition(pos)
+	w.clampToScreen()
+}
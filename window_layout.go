@@ -0,0 +1,250 @@
+package ebui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// windowLayoutVersion is the version of the format written by SaveLayout
+const windowLayoutVersion = 1
+
+// WindowLayout is the saved arrangement of a window.
+// The position and size are the window's normal bounds, so a minimized or maximized window
+// is restored to where it was before it was minimized or maximized.
+type WindowLayout struct {
+	Key     string      `json:"key"`
+	X       float64     `json:"x"`
+	Y       float64     `json:"y"`
+	Width   float64     `json:"width"`
+	Height  float64     `json:"height"`
+	ZIndex  int         `json:"zIndex"`
+	Visible bool        `json:"visible"`
+	State   WindowState `json:"state"` // The state a hidden window returns to when it is shown
+}
+
+// windowManagerLayout is the JSON document written by SaveLayout
+type windowManagerLayout struct {
+	Version int            `json:"version"`
+	Windows []WindowLayout `json:"windows"`
+}
+
+var windowStateNames = map[WindowState]string{
+	WindowStateHidden:    "hidden",
+	WindowStateNormal:    "normal",
+	WindowStateMinimized: "minimized",
+	WindowStateMaximized: "maximized",
+}
+
+// String returns the name of the state
+func (s WindowState) String() string {
+	if name, ok := windowStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("WindowState(%d)", int(s))
+}
+
+// MarshalText encodes the state by name
+func (s WindowState) MarshalText() ([]byte, error) {
+	name, ok := windowStateNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown window state %d", int(s))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a state encoded by MarshalText
+func (s *WindowState) UnmarshalText(text []byte) error {
+	for state, name := range windowStateNames {
+		if name == string(text) {
+			*s = state
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown window state %q", string(text))
+}
+
+// GetWindow returns the window with the given key, or nil if there is none
+func (wm *WindowManager) GetWindow(key string) *Window {
+	for _, window := range wm.getWindows() {
+		if window.key == key {
+			return window
+		}
+	}
+	return nil
+}
+
+// getWindows returns the windows of the window manager, from back to front
+func (wm *WindowManager) getWindows() []*Window {
+	var windows []*Window
+	for _, child := range wm.GetChildren() {
+		if window, ok := child.(*Window); ok {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// GetLayout returns the layout of every window that has a key, from back to front
+func (wm *WindowManager) GetLayout() []WindowLayout {
+	var layouts []WindowLayout
+	for _, window := range wm.getWindows() {
+		if window.key != "" {
+			layouts = append(layouts, window.getLayout())
+		}
+	}
+	return layouts
+}
+
+// ApplyLayout arranges the windows by their keys.
+// Layouts of unknown windows are ignored, and windows without a layout are left as they are.
+// Windows are kept within the window manager bounds, which may have changed since the layout was saved.
+// Docking is not part of the layout, so docked windows are undocked.
+// Windows hidden by the layout are not closed, so their close callbacks are not called.
+func (wm *WindowManager) ApplyLayout(layouts []WindowLayout) {
+	type restoredWindow struct {
+		window *Window
+		zIndex int
+	}
+	var restored []restoredWindow
+	for _, layout := range layouts {
+		if layout.Key == "" {
+			continue
+		}
+		window := wm.GetWindow(layout.Key)
+		if window == nil {
+			continue
+		}
+		window.applyLayout(layout)
+		restored = append(restored, restoredWindow{window, layout.ZIndex})
+	}
+
+	// Restored windows are stacked in front of the others, in their saved order
+	sort.SliceStable(restored, func(i, j int) bool {
+		return restored[i].zIndex < restored[j].zIndex
+	})
+	var top *Window
+	for _, r := range restored {
+		pos := r.window.GetPosition()
+		pos.ZIndex = wm.nextZIndex
+		r.window.SetPosition(pos)
+		wm.nextZIndex++
+		if r.window.IsVisible() {
+			top = r.window
+		}
+	}
+
+	if modal := wm.GetModal(); modal != nil {
+		wm.raiseModal(modal)
+	} else if top != nil {
+		wm.setActiveWindow(top)
+	}
+}
+
+// SaveLayout writes the layout of every window that has a key as JSON
+func (wm *WindowManager) SaveLayout(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(windowManagerLayout{
+		Version: windowLayoutVersion,
+		Windows: wm.GetLayout(),
+	})
+}
+
+// RestoreLayout reads a layout written by SaveLayout and applies it. See ApplyLayout.
+func (wm *WindowManager) RestoreLayout(r io.Reader) error {
+	var layout windowManagerLayout
+	if err := json.NewDecoder(r).Decode(&layout); err != nil {
+		return fmt.Errorf("failed to decode window layout: %w", err)
+	}
+	if layout.Version > windowLayoutVersion {
+		return fmt.Errorf("unsupported window layout version %d", layout.Version)
+	}
+	wm.ApplyLayout(layout.Windows)
+	return nil
+}
+
+// getLayout returns the saved arrangement of the window
+func (w *Window) getLayout() WindowLayout {
+	state := w.state
+	if state == WindowStateHidden {
+		state = w.shownState
+	}
+
+	pos, size := w.GetPosition(), w.GetSize()
+	switch state {
+	case WindowStateMinimized:
+		// Minimized windows can be moved, so only the size is the normal one
+		size = w.normalSize
+	case WindowStateMaximized:
+		pos, size = w.normalPos, w.normalSize
+	}
+
+	return WindowLayout{
+		Key:     w.key,
+		X:       pos.X,
+		Y:       pos.Y,
+		Width:   size.Width,
+		Height:  size.Height,
+		ZIndex:  w.GetPosition().ZIndex,
+		Visible: w.IsVisible(),
+		State:   state,
+	}
+}
+
+// applyLayout arranges the window by a saved layout.
+// The state change callback is notified once, after the layout is applied.
+func (w *Window) applyLayout(layout WindowLayout) {
+	callback, previous := w.stateCallback, w.state
+	w.stateCallback = nil
+
+	w.Undock()
+
+	// A hidden window is arranged in the state it will be shown in
+	hidden := w.state == WindowStateHidden
+	if hidden {
+		w.state = w.shownState
+	}
+
+	w.restoreNormal()
+
+	// Only resizable windows take the saved size, so the size of other windows stays up to the application
+	if w.isResizable {
+		bounds := w.manager.GetSize()
+		w.SetSize(Size{
+			Width:  clamp(layout.Width, w.minSize.Width, w.limitSize(bounds.Width, w.maxSize.Width)),
+			Height: clamp(layout.Height, max(w.minSize.Height, w.headerHeight), w.limitSize(bounds.Height, w.maxSize.Height)),
+		})
+	}
+	pos := w.GetPosition()
+	pos.X, pos.Y = layout.X, layout.Y
+	w.SetPosition(pos)
+	w.isSnapped = false
+	w.clampToScreen()
+
+	switch layout.State {
+	case WindowStateMinimized:
+		w.Minimize()
+	case WindowStateMaximized:
+		w.Maximize()
+	}
+
+	if hidden {
+		w.shownState = w.state
+		w.state = WindowStateHidden
+		w.updateHeaderButtons()
+	}
+
+	if layout.Visible && !w.IsVisible() {
+		w.Show()
+	} else if !layout.Visible && w.IsVisible() {
+		// The window is not being closed, so its close callback is not called
+		w.hide()
+	}
+
+	w.stateCallback = callback
+	if callback != nil && w.state != previous {
+		callback(w.state)
+	}
+}
@@ -0,0 +1,108 @@
+package ebui
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWindowStateText(t *testing.T) {
+	tests := []struct {
+		state WindowState
+		text  string
+	}{
+		{WindowStateHidden, "hidden"},
+		{WindowStateNormal, "normal"},
+		{WindowStateMinimized, "minimized"},
+		{WindowStateMaximized, "maximized"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			text, err := tt.state.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			if string(text) != tt.text {
+				t.Errorf("MarshalText() = %q, want %q", text, tt.text)
+			}
+
+			var state WindowState
+			if err := state.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%q) error = %v", text, err)
+			}
+			if state != tt.state {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", text, state, tt.state)
+			}
+		})
+	}
+
+	if _, err := WindowState(99).MarshalText(); err == nil {
+		t.Error("MarshalText() of an unknown state succeeded")
+	}
+	var state WindowState
+	if err := state.UnmarshalText([]byte("floating")); err == nil {
+		t.Error("UnmarshalText() of an unknown name succeeded")
+	}
+}
+
+func TestWindowLayoutRoundTrip(t *testing.T) {
+	wm := NewWindowManager(WithSize(800, 600))
+	editor := wm.CreateWindow(300, 200, WithWindowKey("editor"), WithResizable(), WithWindowPosition(10, 20))
+	tools := wm.CreateWindow(200, 150, WithWindowKey("tools"), WithResizable(), WithWindowPosition(400, 100))
+	log := wm.CreateWindow(250, 120, WithWindowKey("log"), WithWindowPosition(50, 300))
+	wm.CreateWindow(100, 100) // Windows without a key are not saved
+
+	tools.Maximize()
+	log.hide()
+
+	var buf bytes.Buffer
+	if err := wm.SaveLayout(&buf); err != nil {
+		t.Fatalf("SaveLayout() error = %v", err)
+	}
+	saved := wm.GetLayout()
+
+	// Rearrange everything the layout covers
+	editor.SetSize(Size{Width: 500, Height: 400})
+	editor.moveTo(0, 0)
+	wm.SetActiveWindow(editor)
+	tools.Restore()
+	tools.moveTo(200, 200)
+	log.Show()
+
+	if err := wm.RestoreLayout(&buf); err != nil {
+		t.Fatalf("RestoreLayout() error = %v", err)
+	}
+	restored := wm.GetLayout()
+
+	// Restored windows are given new z-indices, so only their order is compared
+	for _, layouts := range [][]WindowLayout{saved, restored} {
+		for i := range layouts {
+			layouts[i].ZIndex = 0
+		}
+	}
+	if !reflect.DeepEqual(restored, saved) {
+		t.Errorf("restored layout = %+v, want %+v", restored, saved)
+	}
+}
+
+func TestRestoreLayoutErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{name: "invalid JSON", json: "{"},
+		{name: "newer version", json: `{"version": 2, "windows": []}`},
+		{name: "unknown state", json: `{"version": 1, "windows": [{"key": "a", "state": "floating"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := NewWindowManager(WithSize(800, 600))
+			if err := wm.RestoreLayout(strings.NewReader(tt.json)); err == nil {
+				t.Errorf("RestoreLayout(%s) succeeded", tt.json)
+			}
+		})
+	}
+}
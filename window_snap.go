@@ -0,0 +1,492 @@
+package ebui
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font/basicfont"
+)
+
+const (
+	snapZoneEdge      = 6   // Distance from a window manager edge at which the pointer enters a snap zone
+	snapZoneCorner    = 48  // Distance from a corner within which the edge zones snap to quarters
+	windowTabMaxWidth = 120 // Largest width of a header tab of docked windows
+)
+
+// snapZone is an area of the window manager that a dragged window can be snapped to fill
+type snapZone int
+
+const (
+	snapZoneNone snapZone = iota
+	snapZoneLeft
+	snapZoneRight
+	snapZoneTop
+	snapZoneBottom
+	snapZoneTopLeft
+	snapZoneTopRight
+	snapZoneBottomLeft
+	snapZoneBottomRight
+)
+
+// WithSnapThreshold sets the distance within which dragged windows snap to the edges of the window manager
+// and of other windows. A threshold of 0 disables edge snapping. Defaults to 8.
+func WithSnapThreshold(threshold float64) ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.snapThreshold = threshold
+		}
+	}
+}
+
+// WithSnapZones lets resizable windows be snapped to fill half or a quarter of the window manager
+// by dragging them against an edge or a corner. A preview shows the area the window will fill.
+func WithSnapZones() ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.snapZones = true
+		}
+	}
+}
+
+// WithSnapPreviewColor sets the color of the preview shown for snap zones and dock targets
+func WithSnapPreviewColor(color color.Color) ComponentOpt {
+	return func(c Component) {
+		if wm, ok := c.(*WindowManager); ok {
+			wm.snapPreviewColor = color
+		}
+	}
+}
+
+// WithDockable lets the window be docked as a tab into other dockable windows
+// by dropping it on their header, and dragged back out by its tab
+func WithDockable() WindowOpt {
+	return func(w *Window) {
+		w.isDockable = true
+	}
+}
+
+func (wm *WindowManager) Draw(screen *ebiten.Image) {
+	wm.ZIndexedContainer.Draw(screen)
+
+	if pos, size, ok := wm.getSnapPreview(); ok && size.IsDrawable() {
+		r, g, b, _ := wm.snapPreviewColor.RGBA()
+		fill := color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 70}
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X, pos.Y)
+		screen.DrawImage(GetCache().ImageWithColor(int(size.Width), int(size.Height), fill), op)
+		screen.DrawImage(GetCache().BorderImageWithColor(int(size.Width), int(size.Height), wm.snapPreviewColor), op)
+	}
+}
+
+// getSnapPreview returns the bounds a dragged window will take if it is dropped
+func (wm *WindowManager) getSnapPreview() (Position, Size, bool) {
+	if wm.dockTarget != nil {
+		return wm.dockTarget.GetAbsolutePosition(), wm.dockTarget.GetSize(), true
+	}
+	if wm.snapZone != snapZoneNone {
+		pos, size := wm.getSnapZoneBounds(wm.snapZone)
+		wmPos := wm.GetAbsolutePosition()
+		return Position{X: wmPos.X + pos.X, Y: wmPos.Y + pos.Y}, size, true
+	}
+	return Position{}, Size{}, false
+}
+
+// updateSnap previews the dock target or snap zone under the pointer while a window is dragged,
+// otherwise it snaps the window to nearby edges
+func (wm *WindowManager) updateSnap(window *Window, x, y float64) {
+	wm.dockTarget = wm.getDockTargetAt(window, x, y)
+	wm.snapZone = snapZoneNone
+	if wm.dockTarget != nil {
+		return
+	}
+
+	// Only normal windows fill snap zones, since minimized windows keep the size of their header
+	if wm.snapZones && window.isResizable && window.state == WindowStateNormal {
+		if wm.snapZone = wm.getSnapZoneAt(x, y); wm.snapZone != snapZoneNone {
+			return
+		}
+	}
+
+	if wm.snapThreshold > 0 {
+		wm.snapToEdges(window)
+	}
+}
+
+// finishSnap docks or snaps a window dropped on a dock target or snap zone
+func (wm *WindowManager) finishSnap(window *Window) {
+	switch {
+	case wm.dockTarget != nil:
+		wm.dockTarget.Dock(window)
+	case wm.snapZone != snapZoneNone && window.state == WindowStateNormal:
+		window.snapTo(wm.getSnapZoneBounds(wm.snapZone))
+	}
+	wm.cancelSnap()
+}
+
+// cancelSnap clears the dock target and snap zone of a window drag without docking or snapping
+func (wm *WindowManager) cancelSnap() {
+	wm.dockTarget = nil
+	wm.snapZone = snapZoneNone
+}
+
+// getDockTargetAt returns the topmost dockable window, other than the given one, whose header is under the pointer
+func (wm *WindowManager) getDockTargetAt(window *Window, x, y float64) *Window {
+	if !window.isDockable {
+		return nil
+	}
+
+	var target *Window
+	for _, child := range wm.GetChildren() {
+		other, ok := child.(*Window)
+		if !ok || other == window || !other.isDockable || !other.IsVisible() || other.IsHidden() {
+			continue
+		}
+		if other.isOverHeader(x, y) && (target == nil || other.GetPosition().ZIndex > target.GetPosition().ZIndex) {
+			target = other
+		}
+	}
+	return target
+}
+
+// getSnapZoneAt returns the snap zone the pointer is in
+func (wm *WindowManager) getSnapZoneAt(x, y float64) snapZone {
+	pos := wm.GetAbsolutePosition()
+	size := wm.GetSize()
+	x -= pos.X
+	y -= pos.Y
+
+	left := x <= snapZoneEdge
+	right := x >= size.Width-snapZoneEdge
+	top := y <= snapZoneEdge
+	bottom := y >= size.Height-snapZoneEdge
+
+	switch {
+	case (left && y <= snapZoneCorner) || (top && x <= snapZoneCorner):
+		return snapZoneTopLeft
+	case (right && y <= snapZoneCorner) || (top && x >= size.Width-snapZoneCorner):
+		return snapZoneTopRight
+	case (left && y >= size.Height-snapZoneCorner) || (bottom && x <= snapZoneCorner):
+		return snapZoneBottomLeft
+	case (right && y >= size.Height-snapZoneCorner) || (bottom && x >= size.Width-snapZoneCorner):
+		return snapZoneBottomRight
+	case left:
+		return snapZoneLeft
+	case right:
+		return snapZoneRight
+	case top:
+		return snapZoneTop
+	case bottom:
+		return snapZoneBottom
+	}
+	return snapZoneNone
+}
+
+// getSnapZoneBounds returns the area of the window manager a snap zone fills
+func (wm *WindowManager) getSnapZoneBounds(zone snapZone) (Position, Size) {
+	size := wm.GetSize()
+	halfWidth, halfHeight := size.Width/2, size.Height/2
+
+	switch zone {
+	case snapZoneLeft:
+		return Position{}, Size{Width: halfWidth, Height: size.Height}
+	case snapZoneRight:
+		return Position{X: halfWidth}, Size{Width: halfWidth, Height: size.Height}
+	case snapZoneTop:
+		return Position{}, Size{Width: size.Width, Height: halfHeight}
+	case snapZoneBottom:
+		return Position{Y: halfHeight}, Size{Width: size.Width, Height: halfHeight}
+	case snapZoneTopLeft:
+		return Position{}, Size{Width: halfWidth, Height: halfHeight}
+	case snapZoneTopRight:
+		return Position{X: halfWidth}, Size{Width: halfWidth, Height: halfHeight}
+	case snapZoneBottomLeft:
+		return Position{Y: halfHeight}, Size{Width: halfWidth, Height: halfHeight}
+	case snapZoneBottomRight:
+		return Position{X: halfWidth, Y: halfHeight}, Size{Width: halfWidth, Height: halfHeight}
+	}
+	return Position{}, size
+}
+
+// snapToEdges moves a window so its edges line up with the window manager edges
+// or the edges of other windows within the snap threshold
+func (wm *WindowManager) snapToEdges(window *Window) {
+	pos := window.GetPosition()
+	size := window.GetSize()
+	bounds := wm.GetSize()
+	threshold := wm.snapThreshold
+
+	xTargets := []float64{0, bounds.Width}
+	yTargets := []float64{0, bounds.Height}
+	for _, child := range wm.GetChildren() {
+		other, ok := child.(*Window)
+		if !ok || other == window || !other.IsVisible() || other.IsHidden() {
+			continue
+		}
+		otherPos := other.GetPosition()
+		otherSize := other.GetSize()
+
+		// Only snap to the sides of windows that are level with this one
+		if pos.Y < otherPos.Y+otherSize.Height+threshold && otherPos.Y < pos.Y+size.Height+threshold {
+			xTargets = append(xTargets, otherPos.X, otherPos.X+otherSize.Width)
+		}
+		if pos.X < otherPos.X+otherSize.Width+threshold && otherPos.X < pos.X+size.Width+threshold {
+			yTargets = append(yTargets, otherPos.Y, otherPos.Y+otherSize.Height)
+		}
+	}
+
+	pos.X += getSnapOffset([]float64{pos.X, pos.X + size.Width}, xTargets, threshold)
+	pos.Y += getSnapOffset([]float64{pos.Y, pos.Y + size.Height}, yTargets, threshold)
+	window.SetPosition(pos)
+}
+
+// getSnapOffset returns the smallest offset within the threshold that lines up one of the edges with one of the targets
+func getSnapOffset(edges, targets []float64, threshold float64) float64 {
+	offset := 0.0
+	best := threshold + 1
+	for _, edge := range edges {
+		for _, target := range targets {
+			if distance := math.Abs(target - edge); distance <= threshold && distance < best {
+				offset = target - edge
+				best = distance
+			}
+		}
+	}
+	return offset
+}
+
+// snapTo moves and sizes the window to fill a snap zone, remembering its size to restore when it is dragged away
+func (w *Window) snapTo(pos Position, size Size) {
+	if !w.isSnapped {
+		w.preSnapSize = w.GetSize()
+		w.isSnapped = true
+	}
+
+	size.Width = max(w.limitSize(size.Width, w.maxSize.Width), w.minSize.Width)
+	size.Height = max(w.limitSize(size.Height, w.maxSize.Height), w.minSize.Height, w.headerHeight)
+
+	current := w.GetPosition()
+	current.X, current.Y = pos.X, pos.Y
+	w.SetPosition(current)
+	w.SetSize(size)
+}
+
+// unsnap gives a snapped window back its size from before it was snapped,
+// keeping the pointer over the same part of the header
+func (w *Window) unsnap(pointerX float64) {
+	if !w.isSnapped {
+		return
+	}
+	w.isSnapped = false
+
+	pos := w.GetPosition()
+	size := w.GetSize()
+	if size.Width > 0 {
+		ratio := (pointerX - pos.X) / size.Width
+		pos.X = pointerX - ratio*w.preSnapSize.Width
+	}
+	w.SetPosition(pos)
+	w.SetSize(w.preSnapSize)
+}
+
+// windowDockGroup is a set of windows docked as tabs into each other.
+// Only the active window is shown; the others take its bounds when they are activated.
+type windowDockGroup struct {
+	windows []*Window
+	active  *Window
+}
+
+// activate shows a window of the group in place of the active one
+func (g *windowDockGroup) activate(window *Window) {
+	previous := g.active
+	g.active = window
+	if previous != nil && previous != window {
+		window.copyBoundsFrom(previous)
+		previous.hideTab()
+	}
+	window.showTab()
+	window.manager.SetActiveWindow(window)
+}
+
+// updateTitles shows the window titles as tabs when more than one window is docked
+func (g *windowDockGroup) updateTitles() {
+	for _, window := range g.windows {
+		window.updateTitle()
+	}
+}
+
+// Dock docks another window into this one as a tab and switches to it.
+// Both windows must belong to the same window manager.
+func (w *Window) Dock(other *Window) {
+	if other == nil || other == w || other.manager != w.manager {
+		return
+	}
+	if w.dockGroup != nil && other.dockGroup == w.dockGroup {
+		return
+	}
+
+	other.Undock()
+	if w.dockGroup == nil {
+		w.dockGroup = &windowDockGroup{windows: []*Window{w}, active: w}
+	}
+	group := w.dockGroup
+	group.windows = append(group.windows, other)
+	other.dockGroup = group
+	group.activate(other)
+	group.updateTitles()
+}
+
+// Undock removes the window from the windows it is docked with, leaving it in place as a separate window
+func (w *Window) Undock() {
+	group := w.dockGroup
+	if group == nil {
+		return
+	}
+
+	for i, window := range group.windows {
+		if window == w {
+			group.windows = append(group.windows[:i], group.windows[i+1:]...)
+			break
+		}
+	}
+	w.dockGroup = nil
+
+	if group.active == w {
+		// Another tab takes the place of the window, which stays where it is
+		group.activate(group.windows[0])
+		w.showTab()
+	} else {
+		w.copyBoundsFrom(group.active)
+		w.showTab()
+	}
+
+	if len(group.windows) == 1 {
+		group.windows[0].dockGroup = nil
+	}
+	group.updateTitles()
+	w.updateTitle()
+}
+
+// GetDockedWindows returns the windows docked together with this one, including itself, in tab order.
+// It returns nil if the window is not docked.
+func (w *Window) GetDockedWindows() []*Window {
+	if w.dockGroup == nil {
+		return nil
+	}
+	return w.dockGroup.windows
+}
+
+// IsDocked returns whether the window is docked with other windows
+func (w *Window) IsDocked() bool {
+	return w.dockGroup != nil
+}
+
+// hasTabs returns whether the header shows the tabs of docked windows
+func (w *Window) hasTabs() bool {
+	return w.dockGroup != nil && len(w.dockGroup.windows) > 1
+}
+
+// showTab shows a docked window whose tab is selected
+func (w *Window) showTab() {
+	w.LayoutContainer.Show()
+	w.Enable()
+}
+
+// hideTab hides a docked window whose tab is not selected
+func (w *Window) hideTab() {
+	w.LayoutContainer.Hide()
+	w.Disable()
+}
+
+// copyBoundsFrom gives the window the bounds and state of another window
+func (w *Window) copyBoundsFrom(other *Window) {
+	w.restoreNormal()
+
+	normalPos, normalSize := other.GetNormalBounds()
+	pos := w.GetPosition()
+	pos.X, pos.Y = normalPos.X, normalPos.Y
+	w.SetPosition(pos)
+	w.SetSize(normalSize)
+	w.isSnapped, w.preSnapSize = other.isSnapped, other.preSnapSize
+
+	switch other.state {
+	case WindowStateMinimized:
+		w.Minimize()
+	case WindowStateMaximized:
+		w.Maximize()
+	}
+}
+
+// getTabLayout returns where the header tabs of docked windows start and how wide they are.
+// The tabs fill the header between the close button and the minimize and maximize buttons.
+func (w *Window) getTabLayout() (float64, float64) {
+	left := w.closeButton.GetPosition().X + w.closeButton.GetSize().Width + 5
+	right := w.GetSize().Width - 5
+	for _, button := range []*Button{w.minimizeButton, w.maximizeButton} {
+		if button != nil {
+			right = min(right, button.GetPosition().X-5)
+		}
+	}
+
+	tabWidth := min(windowTabMaxWidth, (right-left)/float64(len(w.dockGroup.windows)))
+	return w.GetAbsolutePosition().X + left, max(tabWidth, 0)
+}
+
+// getTabAt returns the docked window whose header tab is under the given point, or nil
+func (w *Window) getTabAt(x, y float64) *Window {
+	if !w.hasTabs() || !w.isOverHeader(x, y) {
+		return nil
+	}
+
+	start, tabWidth := w.getTabLayout()
+	if x < start || tabWidth <= 0 {
+		return nil
+	}
+	index := int((x - start) / tabWidth)
+	if index >= len(w.dockGroup.windows) {
+		return nil
+	}
+	return w.dockGroup.windows[index]
+}
+
+// drawTabs draws the titles of the docked windows as tabs in the header, with the active one joined to the content
+func (w *Window) drawTabs(screen *ebiten.Image) {
+	start, tabWidth := w.getTabLayout()
+	if int(tabWidth) <= 0 {
+		return
+	}
+
+	pos := w.GetAbsolutePosition()
+	top := pos.Y + 4
+	height := w.headerHeight - 4
+	face := basicfont.Face7x13
+	metrics := face.Metrics()
+
+	for i, window := range w.dockGroup.windows {
+		x := start + float64(i)*tabWidth
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(x, top)
+		if window == w.dockGroup.active {
+			screen.DrawImage(GetCache().ImageWithColor(int(tabWidth), int(height), w.colors.Background), op)
+		} else if i > 0 && w.dockGroup.windows[i-1] != w.dockGroup.active {
+			// Separate adjacent inactive tabs
+			op.GeoM.Translate(0, 3)
+			screen.DrawImage(GetCache().ImageWithColor(1, int(height)-6, w.colors.Border), op)
+		}
+
+		// Shorten titles that don't fit the tab
+		title := []rune(window.title)
+		for len(title) > 0 && textBoundsWidth(face, title)+8 > tabWidth {
+			title = title[:len(title)-1]
+		}
+		if len(title) < len([]rune(window.title)) && len(title) > 2 {
+			title = append(title[:len(title)-2], '.', '.')
+		}
+
+		textX := int(x + (tabWidth-textBoundsWidth(face, title))/2)
+		textY := int(top + (height-float64(metrics.Height.Ceil()))/2 + float64(metrics.Ascent.Ceil()))
+		text.Draw(screen, string(title), face, textX, textY, w.colors.HeaderText)
+	}
+}
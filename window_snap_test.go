@@ -0,0 +1,64 @@
+package ebui
+
+import "testing"
+
+func TestGetSnapOffset(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     []float64
+		targets   []float64
+		threshold float64
+		want      float64
+	}{
+		{name: "nothing within threshold", edges: []float64{100, 200}, targets: []float64{0, 800}, threshold: 8, want: 0},
+		{name: "first edge snaps", edges: []float64{5, 105}, targets: []float64{0, 800}, threshold: 8, want: -5},
+		{name: "second edge snaps", edges: []float64{690, 795}, targets: []float64{0, 800}, threshold: 8, want: 5},
+		{name: "closest target wins", edges: []float64{4, 102}, targets: []float64{0, 100}, threshold: 8, want: -2},
+		{name: "first of equally close targets wins", edges: []float64{3, 97}, targets: []float64{0, 100}, threshold: 8, want: -3},
+		{name: "distance equal to threshold snaps", edges: []float64{8, 50}, targets: []float64{0}, threshold: 8, want: -8},
+		{name: "zero threshold", edges: []float64{0.5}, targets: []float64{0}, threshold: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSnapOffset(tt.edges, tt.targets, tt.threshold); got != tt.want {
+				t.Errorf("getSnapOffset(%v, %v, %v) = %v, want %v", tt.edges, tt.targets, tt.threshold, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSnapZoneAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset Position // Position of the window manager
+		x, y   float64
+		want   snapZone
+	}{
+		{name: "center", x: 400, y: 300, want: snapZoneNone},
+		{name: "just past the left edge zone", x: 7, y: 300, want: snapZoneNone},
+		{name: "left", x: 0, y: 300, want: snapZoneLeft},
+		{name: "right", x: 800, y: 300, want: snapZoneRight},
+		{name: "top", x: 400, y: 0, want: snapZoneTop},
+		{name: "bottom", x: 400, y: 600, want: snapZoneBottom},
+		{name: "top left corner", x: 0, y: 0, want: snapZoneTopLeft},
+		{name: "left edge near the top", x: 0, y: 40, want: snapZoneTopLeft},
+		{name: "top edge near the left", x: 40, y: 0, want: snapZoneTopLeft},
+		{name: "left edge below the corner", x: 0, y: 100, want: snapZoneLeft},
+		{name: "top right corner", x: 799, y: 10, want: snapZoneTopRight},
+		{name: "top edge near the right", x: 770, y: 0, want: snapZoneTopRight},
+		{name: "bottom left corner", x: 3, y: 590, want: snapZoneBottomLeft},
+		{name: "bottom right corner", x: 797, y: 597, want: snapZoneBottomRight},
+		{name: "relative to the manager position", offset: Position{X: 100, Y: 50}, x: 100, y: 250, want: snapZoneLeft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := NewWindowManager(WithSize(800, 600))
+			wm.SetPosition(tt.offset)
+			if got := wm.getSnapZoneAt(tt.x, tt.y); got != tt.want {
+				t.Errorf("getSnapZoneAt(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}